@@ -1,23 +1,34 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	_ "github.com/jobayer12/go-kubernetes/docs"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	"github.com/jobayer12/go-kubernetes/module/config"
+	"github.com/jobayer12/go-kubernetes/module/configmap"
 	"github.com/jobayer12/go-kubernetes/module/deployment"
+	"github.com/jobayer12/go-kubernetes/module/event"
 	"github.com/jobayer12/go-kubernetes/module/namespace"
 	"github.com/jobayer12/go-kubernetes/module/pod"
+	"github.com/jobayer12/go-kubernetes/module/secret"
+	"github.com/jobayer12/go-kubernetes/module/service"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/rest"
 	"log"
 	"os"
-	"path/filepath"
 )
 
 type K8sClient struct {
 	Client kubernetes.Interface
+	Config *rest.Config
+	Cache  *cluster.Cache
 }
 
 var (
@@ -30,31 +41,39 @@ var (
 
 	PodController pod.Controller
 	PodRoute      pod.Route
+
+	EventController event.Controller
+	EventRoute      event.Route
+
+	ServiceController service.Controller
+	ServiceRoute      service.Route
+
+	ConfigMapController configmap.Controller
+	ConfigMapRoute      configmap.Route
+
+	SecretController secret.Controller
+	SecretRoute      secret.Route
+
+	ClusterRegistry   *cluster.Registry
+	ClusterController cluster.Controller
+	ClusterRoute      cluster.Route
 )
 
-func getK8sClient() *K8sClient {
-	userHomeDir, err := os.UserHomeDir()
+func setup(cfg *config.Config) error {
+	registry, err := cluster.NewRegistryFromConfig(cfg)
 	if err != nil {
-		fmt.Printf("error getting user home dir: %v\n", err)
-		os.Exit(1)
+		return err
 	}
-	kubeConfigPath := filepath.Join(userHomeDir, ".kube", "config")
+	ClusterRegistry = registry
+	ClusterController = cluster.NewClusterController(registry)
+	ClusterRoute = cluster.NewClusterRoute(ClusterController)
 
-	kubeConfig, err := clientcmd.BuildConfigFromFlags("", kubeConfigPath)
-	if err != nil {
-		log.Fatal(err)
-	}
-	client, err := kubernetes.NewForConfig(kubeConfig)
-	if err != nil {
-		log.Fatal(err)
-	}
-	return &K8sClient{
-		Client: client,
+	registry.StartCaches(make(chan struct{}))
+	if cfg.Reaper {
+		startReapers(cfg, registry)
 	}
-}
-
-func init() {
-	client := getK8sClient()
+	defaultCluster := registry.Default()
+	client := &K8sClient{Client: defaultCluster.Client, Config: defaultCluster.Config, Cache: defaultCluster.Cache}
 	DeploymentController = deployment.NewDeploymentController((*deployment.K8sClient)(client))
 	DeploymentRouteController = deployment.NewDeploymentRoute(DeploymentController)
 
@@ -64,36 +83,105 @@ func init() {
 	PodController = pod.NewPodController((*pod.K8sClient)(client))
 	PodRoute = pod.NewPodRoute(PodController)
 
+	EventController = event.NewEventController((*event.K8sClient)(client))
+	EventRoute = event.NewEventRoute(EventController)
+
+	ServiceController = service.NewServiceController((*service.K8sClient)(client))
+	ServiceRoute = service.NewServiceRoute(ServiceController)
+
+	ConfigMapController = configmap.NewConfigMapController((*configmap.K8sClient)(client))
+	ConfigMapRoute = configmap.NewConfigMapRoute(ConfigMapController)
+
+	SecretController = secret.NewSecretController((*secret.K8sClient)(client), cfg.SecretRevealToken)
+	SecretRoute = secret.NewSecretRoute(SecretController)
+
 	server = gin.Default()
 
 	server.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	server.GET("/readyz", ClusterController.Ready)
+	return nil
 }
 
-// @title Kubernetes API
-// @version 1.0
-// @description List of kubernetes API
-// @host localhost:8080
-// @BasePath /
-func main() {
-	server.ForwardedByClientIP = true
-	server.ForwardedByClientIP = true
-	err := server.SetTrustedProxies([]string{"127.0.0.1"})
+// startReapers runs a namespace reaper for every registered cluster. The
+// identity is unique per process so replicas compete for the same Lease.
+func startReapers(cfg *config.Config, registry *cluster.Registry) {
+	hostname, err := os.Hostname()
 	if err != nil {
-		log.Fatal(err)
+		hostname = "go-kubernetes"
+	}
+	identity := hostname + "_" + string(uuid.NewUUID())
+	for _, c := range registry.List() {
+		reaper := &namespace.Reaper{
+			Client:         c.Client,
+			Cache:          c.Cache,
+			Interval:       cfg.ReaperInterval,
+			LeaseNamespace: cfg.ReaperLeaseNamespace,
+			Identity:       identity,
+		}
+		go reaper.Run(context.Background(), c.Name)
 	}
+}
 
-	deploymentRoute := server.Group("/apis/apps/v1/:namespace/deployments")
+// registerRoutes mounts every kubernetes route on router. It is used once for
+// the default cluster and once under the /clusters/:cluster prefix.
+func registerRoutes(router *gin.RouterGroup) {
+	deploymentRoute := router.Group("/apis/apps/v1/:namespace/deployments")
 	DeploymentRouteController.DeploymentRoute(deploymentRoute)
+	EventRoute.DeploymentRoute(deploymentRoute)
+	DeploymentRouteController.AllNamespacesRoute(router.Group("/apis/apps/v1/deployments"))
+	EventRoute.EventsV1Route(router.Group("/apis/events.k8s.io/v1"))
 
-	apiV1 := server.Group("/api/v1")
+	apiV1 := router.Group("/api/v1")
 	{
+		PodRoute.AllNamespacesRoute(apiV1.Group("pods"))
+		EventRoute.AllNamespacesRoute(apiV1.Group("events"))
+		ServiceRoute.AllNamespacesRoute(apiV1.Group("services"))
+		ConfigMapRoute.AllNamespacesRoute(apiV1.Group("configmaps"))
+		SecretRoute.AllNamespacesRoute(apiV1.Group("secrets"))
 		namespaceGroup := apiV1.Group("namespaces")
 		NamespaceRoute.Route(namespaceGroup)
 		{
 			podRoute := namespaceGroup.Group(":namespace/pods")
 			PodRoute.Route(podRoute)
+			EventRoute.PodRoute(podRoute)
+			EventRoute.Route(namespaceGroup.Group(":namespace/events"))
+			ServiceRoute.Route(namespaceGroup.Group(":namespace/services"))
+			ConfigMapRoute.Route(namespaceGroup.Group(":namespace/configmaps"))
+			SecretRoute.Route(namespaceGroup.Group(":namespace/secrets"))
 		}
 	}
+}
+
+// @title Kubernetes API
+// @version 1.0
+// @description List of kubernetes API
+// @host localhost:8080
+// @BasePath /
+func main() {
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "invalid configuration: %v\n", err)
+		os.Exit(2)
+	}
+	if err := setup(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to connect to kubernetes: %v\n", err)
+		os.Exit(1)
+	}
+
+	server.ForwardedByClientIP = true
+	server.ForwardedByClientIP = true
+	err = server.SetTrustedProxies([]string{"127.0.0.1"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	registerRoutes(&server.RouterGroup)
+
+	ClusterRoute.Route(server.Group("/clusters"))
+	registerRoutes(server.Group("/clusters/:cluster", ClusterRegistry.Resolve()))
 
-	log.Fatal(server.Run(":8080"))
+	log.Fatal(server.Run(cfg.Address))
 }
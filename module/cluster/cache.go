@@ -0,0 +1,144 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	ConsistencyStrong = "strong"
+	ConsistencyCached = "cached"
+)
+
+// Cache is an informer backed read cache of the objects served by the list
+// and get endpoints. Reads fall back to the apiserver until it has synced.
+type Cache struct {
+	factory     informers.SharedInformerFactory
+	pods        cache.SharedIndexInformer
+	deployments cache.SharedIndexInformer
+	namespaces  cache.SharedIndexInformer
+	syncTimeout time.Duration
+	synced      atomic.Bool
+	// syncTimedOut is set when the initial sync exceeded syncTimeout.
+	syncTimedOut atomic.Bool
+}
+
+func NewCache(client kubernetes.Interface, resync, syncTimeout time.Duration) *Cache {
+	factory := informers.NewSharedInformerFactory(client, resync)
+	return &Cache{
+		factory:     factory,
+		syncTimeout: syncTimeout,
+		pods:        factory.Core().V1().Pods().Informer(),
+		deployments: factory.Apps().V1().Deployments().Informer(),
+		namespaces:  factory.Core().V1().Namespaces().Informer(),
+	}
+}
+
+// Start runs the informers until stop is closed and marks the cache synced
+// once every informer has completed its initial list. When that takes longer
+// than the sync timeout, e.g. because list/watch is not permitted, reads keep
+// going to the apiserver and the cache is only used if it syncs later.
+func (c *Cache) Start(name string, stop <-chan struct{}) {
+	c.factory.Start(stop)
+	go func() {
+		ctx := wait.ContextForChannel(stop)
+		syncCtx, cancel := context.WithTimeout(ctx, c.syncTimeout)
+		defer cancel()
+		if !c.waitForSync(name, syncCtx.Done()) {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("cluster %q: cache did not sync within %s, serving reads from the apiserver", name, c.syncTimeout)
+			c.syncTimedOut.Store(true)
+			if !c.waitForSync(name, stop) {
+				return
+			}
+			log.Printf("cluster %q: cache synced", name)
+		}
+		c.synced.Store(true)
+	}()
+}
+
+// waitForSync waits until every informer has synced or stop is closed.
+func (c *Cache) waitForSync(name string, stop <-chan struct{}) bool {
+	for informerType, ok := range c.factory.WaitForCacheSync(stop) {
+		if !ok {
+			log.Printf("cluster %q: cache for %v did not sync", name, informerType)
+			return false
+		}
+	}
+	return true
+}
+
+// Synced reports whether the cache can serve reads. A nil cache never syncs.
+func (c *Cache) Synced() bool {
+	return c != nil && c.synced.Load()
+}
+
+// Ready reports whether the cache no longer holds up readiness: it has synced,
+// or gave up waiting and reads are served from the apiserver.
+func (c *Cache) Ready() bool {
+	return c == nil || c.synced.Load() || c.syncTimedOut.Load()
+}
+
+func (c *Cache) Pods() corelisters.PodLister {
+	return corelisters.NewPodLister(c.pods.GetIndexer())
+}
+
+func (c *Cache) Deployments() appslisters.DeploymentLister {
+	return appslisters.NewDeploymentLister(c.deployments.GetIndexer())
+}
+
+func (c *Cache) Namespaces() corelisters.NamespaceLister {
+	return corelisters.NewNamespaceLister(c.namespaces.GetIndexer())
+}
+
+// PodsResourceVersion returns the resourceVersion the pod cache last synced at.
+func (c *Cache) PodsResourceVersion() string {
+	return c.pods.LastSyncResourceVersion()
+}
+
+func (c *Cache) DeploymentsResourceVersion() string {
+	return c.deployments.LastSyncResourceVersion()
+}
+
+func (c *Cache) NamespacesResourceVersion() string {
+	return c.namespaces.LastSyncResourceVersion()
+}
+
+// CacheFor returns the cache of the cluster resolved for the request, or
+// fallback when the request is served for the default cluster.
+func CacheFor(ctx *gin.Context, fallback *Cache) *Cache {
+	if c, ok := FromContext(ctx); ok {
+		return c.Cache
+	}
+	return fallback
+}
+
+// UseCache decides whether a read is served from c, based on the consistency
+// query parameter: "strong" always reads from the apiserver, "cached" (the
+// default) reads from the cache once it has synced and the request is
+// cacheable. The chosen source is reported in the X-Consistency response header.
+func UseCache(ctx *gin.Context, c *Cache, cacheable bool) (bool, error) {
+	consistency := ctx.DefaultQuery("consistency", ConsistencyCached)
+	if consistency != ConsistencyStrong && consistency != ConsistencyCached {
+		return false, fmt.Errorf("consistency must be %q or %q", ConsistencyStrong, ConsistencyCached)
+	}
+	cached := consistency == ConsistencyCached && cacheable && c.Synced()
+	if cached {
+		ctx.Header("X-Consistency", ConsistencyCached)
+	} else {
+		ctx.Header("X-Consistency", ConsistencyStrong)
+	}
+	return cached, nil
+}
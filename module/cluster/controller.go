@@ -0,0 +1,109 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/version"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const probeTimeout = 5 * time.Second
+
+type Info struct {
+	Name      string `json:"name"`
+	Context   string `json:"context,omitempty"`
+	Server    string `json:"server"`
+	Default   bool   `json:"default"`
+	Reachable bool   `json:"reachable"`
+	Version   string `json:"version,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type Controller struct {
+	registry *Registry
+}
+
+func NewClusterController(registry *Registry) Controller {
+	return Controller{registry: registry}
+}
+
+// ListCluster
+// @Summary			Get the List of registered clusters.
+// @Description		Return every cluster with its reachability and server version. All other routes are also served under /clusters/{cluster}.
+// @Tags			cluster
+// @Router			/clusters [get]
+// @Response		200 {array} Info
+// @Produce			application/json
+func (cc *Controller) ListCluster(ctx *gin.Context) {
+	clusters := cc.registry.List()
+	infos := make([]Info, len(clusters))
+	var wg sync.WaitGroup
+	for i, c := range clusters {
+		infos[i] = Info{
+			Name:    c.Name,
+			Context: c.Context,
+			Server:  c.Config.Host,
+			Default: i == 0,
+		}
+		wg.Add(1)
+		go func(info *Info, c *Cluster) {
+			defer wg.Done()
+			probe(ctx.Request.Context(), info, c)
+		}(&infos[i], c)
+	}
+	wg.Wait()
+	ctx.JSON(http.StatusOK, infos)
+}
+
+type ReadinessResponse struct {
+	Ready    bool            `json:"ready"`
+	Clusters map[string]bool `json:"clusters"`
+}
+
+// Ready
+// @Summary			Readiness probe.
+// @Description		Return 200 once the read caches of every cluster have synced or given up syncing, 503 before.
+// @Tags			cluster
+// @Router			/readyz [get]
+// @Response		200 {object} ReadinessResponse
+// @Response		503 {object} ReadinessResponse
+// @Produce			application/json
+func (cc *Controller) Ready(ctx *gin.Context) {
+	response := ReadinessResponse{Ready: cc.registry.Ready(), Clusters: map[string]bool{}}
+	for _, c := range cc.registry.List() {
+		response.Clusters[c.Name] = c.Cache.Ready()
+	}
+	if !response.Ready {
+		ctx.JSON(http.StatusServiceUnavailable, response)
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// probe fetches the server version of c, giving up after probeTimeout or when
+// ctx is cancelled so a hanging apiserver does not leak the request.
+func probe(ctx context.Context, info *Info, c *Cluster) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	body, err := c.Client.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Raw()
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			info.Error = "timed out waiting for the server version"
+			return
+		}
+		info.Error = err.Error()
+		return
+	}
+	var serverVersion version.Info
+	if err := json.Unmarshal(body, &serverVersion); err != nil {
+		info.Error = fmt.Sprintf("decoding the server version: %v", err)
+		return
+	}
+	info.Reachable = true
+	info.Version = serverVersion.GitVersion
+}
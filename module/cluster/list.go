@@ -0,0 +1,44 @@
+package cluster
+
+import (
+	"context"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ListDeployments lists the deployments of a namespace from cache once it has
+// synced, and from the apiserver otherwise. cache may be nil.
+func ListDeployments(ctx context.Context, client kubernetes.Interface, cache *Cache, namespace string) ([]*appsv1.Deployment, error) {
+	if cache.Synced() {
+		return cache.Deployments().Deployments(namespace).List(labels.Everything())
+	}
+	list, err := client.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	deployments := make([]*appsv1.Deployment, 0, len(list.Items))
+	for i := range list.Items {
+		deployments = append(deployments, &list.Items[i])
+	}
+	return deployments, nil
+}
+
+// ListPods lists the pods of a namespace matching selector from cache once it
+// has synced, and from the apiserver otherwise. cache may be nil.
+func ListPods(ctx context.Context, client kubernetes.Interface, cache *Cache, namespace string, selector labels.Selector) ([]*v1.Pod, error) {
+	if cache.Synced() {
+		return cache.Pods().Pods(namespace).List(selector)
+	}
+	list, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]*v1.Pod, 0, len(list.Items))
+	for i := range list.Items {
+		pods = append(pods, &list.Items[i])
+	}
+	return pods, nil
+}
@@ -0,0 +1,189 @@
+package cluster
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/common"
+	"github.com/jobayer12/go-kubernetes/module/config"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const contextKey = "cluster"
+
+// Cluster is a single Kubernetes cluster the server can talk to.
+type Cluster struct {
+	Name    string
+	Context string
+	Config  *rest.Config
+	Client  kubernetes.Interface
+	// Cache is nil when caching is disabled.
+	Cache *Cache
+}
+
+// Registry holds every cluster loaded at startup. The first cluster added is
+// the default one, served without the /clusters/{cluster} prefix.
+type Registry struct {
+	clusters map[string]*Cluster
+	names    []string
+}
+
+func NewRegistry() *Registry {
+	return &Registry{clusters: map[string]*Cluster{}}
+}
+
+// NewRegistryFromConfig connects to the default cluster and to every context listed in --clusters.
+func NewRegistryFromConfig(cfg *config.Config) (*Registry, error) {
+	registry := NewRegistry()
+	restConfig, err := cfg.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	name, context := cfg.DefaultClusterName(), cfg.Context
+	if context == "" && !cfg.InCluster {
+		context = name
+	}
+	defaultCluster, err := newCluster(name, context, restConfig, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := registry.Add(defaultCluster); err != nil {
+		return nil, err
+	}
+
+	contexts, err := cfg.ClusterContexts()
+	if err != nil {
+		return nil, err
+	}
+	for _, clusterContext := range contexts {
+		if _, ok := registry.Get(clusterContext.Name); ok {
+			continue
+		}
+		restConfig, err := cfg.RestConfigForContext(clusterContext.Context)
+		if err != nil {
+			return nil, err
+		}
+		c, err := newCluster(clusterContext.Name, clusterContext.Context, restConfig, cfg)
+		if err != nil {
+			return nil, err
+		}
+		if err := registry.Add(c); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+func newCluster(name, context string, restConfig *rest.Config, cfg *config.Config) (*Cluster, error) {
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("creating kubernetes client for cluster %q: %w", name, err)
+	}
+	c := &Cluster{
+		Name:    name,
+		Context: context,
+		Config:  restConfig,
+		Client:  client,
+	}
+	if cfg.Cache {
+		c.Cache = NewCache(client, cfg.CacheResync, cfg.CacheSyncTimeout)
+	}
+	return c, nil
+}
+
+// Add registers c, failing when a cluster with the same name already exists.
+func (r *Registry) Add(c *Cluster) error {
+	if _, ok := r.clusters[c.Name]; ok {
+		return fmt.Errorf("cluster %q is already registered", c.Name)
+	}
+	r.clusters[c.Name] = c
+	r.names = append(r.names, c.Name)
+	return nil
+}
+
+func (r *Registry) Get(name string) (*Cluster, bool) {
+	c, ok := r.clusters[name]
+	return c, ok
+}
+
+// Default returns the cluster served without a cluster prefix.
+func (r *Registry) Default() *Cluster {
+	if len(r.names) == 0 {
+		return nil
+	}
+	return r.clusters[r.names[0]]
+}
+
+// List returns the registered clusters in registration order.
+func (r *Registry) List() []*Cluster {
+	clusters := make([]*Cluster, 0, len(r.names))
+	for _, name := range r.names {
+		clusters = append(clusters, r.clusters[name])
+	}
+	return clusters
+}
+
+// StartCaches starts the informers of every cluster until stop is closed.
+func (r *Registry) StartCaches(stop <-chan struct{}) {
+	for _, c := range r.List() {
+		if c.Cache != nil {
+			c.Cache.Start(c.Name, stop)
+		}
+	}
+}
+
+// Ready reports whether every enabled cache has synced or given up syncing.
+func (r *Registry) Ready() bool {
+	for _, c := range r.List() {
+		if !c.Cache.Ready() {
+			return false
+		}
+	}
+	return true
+}
+
+// Resolve is a middleware that looks up the :cluster path parameter and stores
+// the matching cluster on the request so controllers use its client.
+func (r *Registry) Resolve() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		name := ctx.Param("cluster")
+		c, ok := r.Get(name)
+		if !ok {
+			common.Error(ctx, apierrors.NewNotFound(schema.GroupResource{Resource: "clusters"}, name))
+			ctx.Abort()
+			return
+		}
+		ctx.Set(contextKey, c)
+		ctx.Next()
+	}
+}
+
+// FromContext returns the cluster resolved for the request, if any.
+func FromContext(ctx *gin.Context) (*Cluster, bool) {
+	value, ok := ctx.Get(contextKey)
+	if !ok {
+		return nil, false
+	}
+	c, ok := value.(*Cluster)
+	return c, ok
+}
+
+// Client returns the client of the cluster resolved for the request, or fallback
+// when the request is served for the default cluster.
+func Client(ctx *gin.Context, fallback kubernetes.Interface) kubernetes.Interface {
+	if c, ok := FromContext(ctx); ok {
+		return c.Client
+	}
+	return fallback
+}
+
+// RestConfig returns the client configuration of the cluster resolved for the
+// request, or fallback when the request is served for the default cluster.
+func RestConfig(ctx *gin.Context, fallback *rest.Config) *rest.Config {
+	if c, ok := FromContext(ctx); ok {
+		return c.Config
+	}
+	return fallback
+}
@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+func newTestCluster(name string) *Cluster {
+	return &Cluster{
+		Name:    name,
+		Context: "context-" + name,
+		Config:  &rest.Config{Host: "https://" + name + ".example.com"},
+		Client:  fake.NewSimpleClientset(),
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	if registry.Default() != nil {
+		t.Error("an empty registry has a default cluster")
+	}
+	for _, name := range []string{"prod", "staging", "dev"} {
+		if err := registry.Add(newTestCluster(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := registry.Add(newTestCluster("staging")); err == nil {
+		t.Error("a duplicate cluster name was registered")
+	}
+	if name := registry.Default().Name; name != "prod" {
+		t.Errorf("got default cluster %q, want the first one registered", name)
+	}
+	var names []string
+	for _, c := range registry.List() {
+		names = append(names, c.Name)
+	}
+	if want := []string{"prod", "staging", "dev"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got clusters %v, want %v", names, want)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	registry := NewRegistry()
+	for _, name := range []string{"prod", "staging"} {
+		if err := registry.Add(newTestCluster(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fallbackClient := fake.NewSimpleClientset()
+	fallbackConfig := &rest.Config{Host: "https://default.example.com"}
+	clusterOf := func(client kubernetes.Interface) string {
+		for _, c := range registry.List() {
+			if c.Client == client {
+				return c.Name
+			}
+		}
+		if client == fallbackClient {
+			return "fallback"
+		}
+		return "unknown"
+	}
+	handler := func(ctx *gin.Context) {
+		ctx.String(http.StatusOK, clusterOf(Client(ctx, fallbackClient))+" "+RestConfig(ctx, fallbackConfig).Host)
+	}
+	router := gin.New()
+	router.GET("/namespaces", handler)
+	router.Group("/clusters/:cluster", registry.Resolve()).GET("/namespaces", handler)
+
+	tests := []struct {
+		name     string
+		path     string
+		wantCode int
+		want     string
+	}{
+		{name: "named cluster", path: "/clusters/staging/namespaces", wantCode: http.StatusOK, want: "staging https://staging.example.com"},
+		{name: "default cluster by name", path: "/clusters/prod/namespaces", wantCode: http.StatusOK, want: "prod https://prod.example.com"},
+		{name: "without a cluster prefix", path: "/namespaces", wantCode: http.StatusOK, want: "fallback https://default.example.com"},
+		{name: "unknown cluster", path: "/clusters/qa/namespaces", wantCode: http.StatusNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, test.path, nil))
+			if recorder.Code != test.wantCode {
+				t.Fatalf("got status %d, want %d: %s", recorder.Code, test.wantCode, recorder.Body.String())
+			}
+			if test.want != "" && recorder.Body.String() != test.want {
+				t.Errorf("got cluster %q, want %q", recorder.Body.String(), test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package cluster
+
+import "github.com/gin-gonic/gin"
+
+type Route struct {
+	controller Controller
+}
+
+func NewClusterRoute(controller Controller) Route {
+	return Route{controller}
+}
+
+func (r *Route) Route(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListCluster)
+}
@@ -0,0 +1,112 @@
+package common
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/scheme"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// maxManifestSize bounds the size of a manifest accepted in a request body.
+const maxManifestSize = 3 * 1024 * 1024
+
+var manifestMediaTypes = map[string]bool{
+	"application/json":   true,
+	"application/yaml":   true,
+	"application/x-yaml": true,
+	"text/yaml":          true,
+}
+
+// DecodeManifest reads a JSON or YAML manifest from the request body and decodes
+// it with the universal deserializer into a typed object.
+func DecodeManifest(ctx *gin.Context) (runtime.Object, *schema.GroupVersionKind, error) {
+	mediaType, _, err := mime.ParseMediaType(ctx.ContentType())
+	if err != nil || !manifestMediaTypes[mediaType] {
+		return nil, nil, UnsupportedMediaType(ctx.ContentType(), "application/json", "application/yaml")
+	}
+	body, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, nil, apierrors.NewBadRequest(err.Error())
+	}
+	if len(body) > maxManifestSize {
+		return nil, nil, apierrors.NewRequestEntityTooLargeError(fmt.Sprintf("manifest exceeds %d bytes", maxManifestSize))
+	}
+	obj, gvk, err := scheme.Codecs.UniversalDeserializer().Decode(body, nil, nil)
+	if err != nil {
+		return nil, nil, apierrors.NewBadRequest(fmt.Sprintf("unable to decode manifest: %v", err))
+	}
+	return obj, gvk, nil
+}
+
+// ValidateManifestMeta checks that a decoded object has the expected kind and
+// that its namespace and name agree with the request path. An empty name skips
+// the name check.
+func ValidateManifestMeta(gvk *schema.GroupVersionKind, expected schema.GroupVersionKind, meta metav1.Object, namespace, name string) error {
+	var errs field.ErrorList
+	if gvk.GroupKind() != expected.GroupKind() {
+		errs = append(errs, field.Invalid(field.NewPath("kind"), gvk.Kind, fmt.Sprintf("must be %s", expected.Kind)))
+	}
+	if gvk.Version != expected.Version {
+		errs = append(errs, field.Invalid(field.NewPath("apiVersion"), gvk.GroupVersion().String(), fmt.Sprintf("must be %s", expected.GroupVersion().String())))
+	}
+	if meta != nil {
+		if meta.GetNamespace() != "" && meta.GetNamespace() != namespace {
+			errs = append(errs, field.Invalid(field.NewPath("metadata", "namespace"), meta.GetNamespace(), fmt.Sprintf("does not match the namespace %q in the request path", namespace)))
+		}
+		if name != "" && meta.GetName() != name {
+			errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), meta.GetName(), fmt.Sprintf("does not match the name %q in the request path", name)))
+		}
+		if name == "" && meta.GetName() == "" && meta.GetGenerateName() == "" {
+			errs = append(errs, field.Required(field.NewPath("metadata", "name"), "name or generateName is required"))
+		}
+	}
+	if len(errs) > 0 {
+		objectName := name
+		if meta != nil && objectName == "" {
+			objectName = meta.GetName()
+		}
+		return apierrors.NewInvalid(expected.GroupKind(), objectName, errs)
+	}
+	return nil
+}
+
+// ValidateDataKey rejects a key that a ConfigMap or Secret of kind cannot hold.
+func ValidateDataKey(kind schema.GroupKind, name, key string) error {
+	if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+		return apierrors.NewInvalid(kind, name, field.ErrorList{
+			field.Invalid(field.NewPath("data").Key(key), key, strings.Join(errs, "; ")),
+		})
+	}
+	return nil
+}
+
+// DryRun reads the dryRun query parameter, which only accepts "All".
+func DryRun(ctx *gin.Context) ([]string, error) {
+	switch value := ctx.Query("dryRun"); value {
+	case "":
+		return nil, nil
+	case metav1.DryRunAll:
+		return []string{metav1.DryRunAll}, nil
+	default:
+		return nil, fmt.Errorf("unsupported dryRun value %q, only %q is allowed", value, metav1.DryRunAll)
+	}
+}
+
+// UnsupportedMediaType returns a 415 error listing the accepted content types.
+func UnsupportedMediaType(contentType string, accepted ...string) error {
+	return &apierrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusUnsupportedMediaType,
+		Reason:  metav1.StatusReasonUnsupportedMediaType,
+		Message: fmt.Sprintf("unsupported content type %q, expected one of %v", contentType, accepted),
+	}}
+}
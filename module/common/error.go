@@ -0,0 +1,135 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"net/http"
+	"strconv"
+)
+
+// ErrorResponse is the envelope returned by every handler when a request fails.
+type ErrorResponse struct {
+	Code              int          `json:"code"`
+	Reason            string       `json:"reason"`
+	Message           string       `json:"message"`
+	Kind              string       `json:"kind,omitempty"`
+	Name              string       `json:"name,omitempty"`
+	Causes            []ErrorCause `json:"causes,omitempty"`
+	RetryAfterSeconds int32        `json:"retryAfterSeconds,omitempty"`
+}
+
+// ErrorCause describes a single field level problem reported by the apiserver.
+type ErrorCause struct {
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message,omitempty"`
+	Field   string `json:"field,omitempty"`
+}
+
+var reasonStatusCode = map[metav1.StatusReason]int{
+	metav1.StatusReasonBadRequest:            http.StatusBadRequest,
+	metav1.StatusReasonUnauthorized:          http.StatusUnauthorized,
+	metav1.StatusReasonForbidden:             http.StatusForbidden,
+	metav1.StatusReasonNotFound:              http.StatusNotFound,
+	metav1.StatusReasonMethodNotAllowed:      http.StatusMethodNotAllowed,
+	metav1.StatusReasonNotAcceptable:         http.StatusNotAcceptable,
+	metav1.StatusReasonAlreadyExists:         http.StatusConflict,
+	metav1.StatusReasonConflict:              http.StatusConflict,
+	metav1.StatusReasonGone:                  http.StatusGone,
+	metav1.StatusReasonExpired:               http.StatusGone,
+	metav1.StatusReasonRequestEntityTooLarge: http.StatusRequestEntityTooLarge,
+	metav1.StatusReasonUnsupportedMediaType:  http.StatusUnsupportedMediaType,
+	metav1.StatusReasonInvalid:               http.StatusUnprocessableEntity,
+	metav1.StatusReasonTooManyRequests:       http.StatusTooManyRequests,
+	metav1.StatusReasonInternalError:         http.StatusInternalServerError,
+	metav1.StatusReasonServerTimeout:         http.StatusInternalServerError,
+	metav1.StatusReasonServiceUnavailable:    http.StatusServiceUnavailable,
+	metav1.StatusReasonTimeout:               http.StatusGatewayTimeout,
+}
+
+// codeReason picks the reason reported for a status that only carries a code.
+var codeReason = map[int]metav1.StatusReason{
+	http.StatusBadRequest:            metav1.StatusReasonBadRequest,
+	http.StatusUnauthorized:          metav1.StatusReasonUnauthorized,
+	http.StatusForbidden:             metav1.StatusReasonForbidden,
+	http.StatusNotFound:              metav1.StatusReasonNotFound,
+	http.StatusMethodNotAllowed:      metav1.StatusReasonMethodNotAllowed,
+	http.StatusNotAcceptable:         metav1.StatusReasonNotAcceptable,
+	http.StatusConflict:              metav1.StatusReasonConflict,
+	http.StatusGone:                  metav1.StatusReasonGone,
+	http.StatusRequestEntityTooLarge: metav1.StatusReasonRequestEntityTooLarge,
+	http.StatusUnsupportedMediaType:  metav1.StatusReasonUnsupportedMediaType,
+	http.StatusUnprocessableEntity:   metav1.StatusReasonInvalid,
+	http.StatusTooManyRequests:       metav1.StatusReasonTooManyRequests,
+	http.StatusInternalServerError:   metav1.StatusReasonInternalError,
+	http.StatusServiceUnavailable:    metav1.StatusReasonServiceUnavailable,
+	http.StatusGatewayTimeout:        metav1.StatusReasonTimeout,
+}
+
+// NewErrorResponse converts err into an ErrorResponse. Kubernetes API errors keep
+// their status code, reason and causes; anything else is reported as an internal error.
+func NewErrorResponse(err error) ErrorResponse {
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		s := status.Status()
+		response := ErrorResponse{
+			Code:    int(s.Code),
+			Reason:  string(s.Reason),
+			Message: s.Message,
+		}
+		if response.Code == 0 {
+			response.Code = codeForReason(s.Reason)
+		}
+		if response.Reason == "" {
+			response.Reason = string(codeReason[response.Code])
+		}
+		if s.Details != nil {
+			response.Kind = s.Details.Kind
+			response.Name = s.Details.Name
+			response.RetryAfterSeconds = s.Details.RetryAfterSeconds
+			for _, cause := range s.Details.Causes {
+				response.Causes = append(response.Causes, ErrorCause{
+					Type:    string(cause.Type),
+					Message: cause.Message,
+					Field:   cause.Field,
+				})
+			}
+		}
+		return response
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorResponse{
+			Code:    http.StatusGatewayTimeout,
+			Reason:  string(metav1.StatusReasonTimeout),
+			Message: err.Error(),
+		}
+	}
+	return ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Reason:  string(metav1.StatusReasonInternalError),
+		Message: err.Error(),
+	}
+}
+
+func codeForReason(reason metav1.StatusReason) int {
+	if code, ok := reasonStatusCode[reason]; ok {
+		return code
+	}
+	return http.StatusInternalServerError
+}
+
+// Error writes err to the client using the matching HTTP status code.
+func Error(ctx *gin.Context, err error) {
+	response := NewErrorResponse(err)
+	if response.RetryAfterSeconds > 0 {
+		ctx.Header("Retry-After", strconv.Itoa(int(response.RetryAfterSeconds)))
+	}
+	ctx.JSON(response.Code, response)
+}
+
+// BadRequest writes a 400 response for invalid client input.
+func BadRequest(ctx *gin.Context, err error) {
+	Error(ctx, apierrors.NewBadRequest(err.Error()))
+}
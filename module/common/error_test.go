@@ -0,0 +1,134 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestNewErrorResponseReasonWithoutCode(t *testing.T) {
+	tests := []struct {
+		reason metav1.StatusReason
+		want   int
+	}{
+		{metav1.StatusReasonBadRequest, http.StatusBadRequest},
+		{metav1.StatusReasonUnauthorized, http.StatusUnauthorized},
+		{metav1.StatusReasonForbidden, http.StatusForbidden},
+		{metav1.StatusReasonNotFound, http.StatusNotFound},
+		{metav1.StatusReasonMethodNotAllowed, http.StatusMethodNotAllowed},
+		{metav1.StatusReasonNotAcceptable, http.StatusNotAcceptable},
+		{metav1.StatusReasonAlreadyExists, http.StatusConflict},
+		{metav1.StatusReasonConflict, http.StatusConflict},
+		{metav1.StatusReasonGone, http.StatusGone},
+		{metav1.StatusReasonExpired, http.StatusGone},
+		{metav1.StatusReasonRequestEntityTooLarge, http.StatusRequestEntityTooLarge},
+		{metav1.StatusReasonUnsupportedMediaType, http.StatusUnsupportedMediaType},
+		{metav1.StatusReasonInvalid, http.StatusUnprocessableEntity},
+		{metav1.StatusReasonTooManyRequests, http.StatusTooManyRequests},
+		{metav1.StatusReasonInternalError, http.StatusInternalServerError},
+		{metav1.StatusReasonServerTimeout, http.StatusInternalServerError},
+		{metav1.StatusReasonServiceUnavailable, http.StatusServiceUnavailable},
+		{metav1.StatusReasonTimeout, http.StatusGatewayTimeout},
+		{"SomethingNew", http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		t.Run(string(test.reason), func(t *testing.T) {
+			err := &apierrors.StatusError{ErrStatus: metav1.Status{Reason: test.reason, Message: "failed"}}
+			response := NewErrorResponse(err)
+			if response.Code != test.want {
+				t.Errorf("got code %d, want %d", response.Code, test.want)
+			}
+			if response.Reason != string(test.reason) {
+				t.Errorf("got reason %q, want %q", response.Reason, test.reason)
+			}
+		})
+	}
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	pods := schema.GroupResource{Resource: "pods"}
+	tests := []struct {
+		name string
+		err  error
+		want ErrorResponse
+	}{
+		{
+			name: "not found",
+			err:  apierrors.NewNotFound(pods, "web"),
+			want: ErrorResponse{Code: http.StatusNotFound, Reason: "NotFound", Message: `pods "web" not found`, Kind: "pods", Name: "web"},
+		},
+		{
+			name: "wrapped api error",
+			err:  fmt.Errorf("getting pod: %w", apierrors.NewForbidden(pods, "web", errors.New("denied"))),
+			want: ErrorResponse{Code: http.StatusForbidden, Reason: "Forbidden", Message: `pods "web" is forbidden: denied`, Kind: "pods", Name: "web"},
+		},
+		{
+			name: "invalid with causes",
+			err: apierrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "web", field.ErrorList{
+				field.Required(field.NewPath("spec", "containers"), "at least one container"),
+			}),
+			want: ErrorResponse{
+				Code:    http.StatusUnprocessableEntity,
+				Reason:  "Invalid",
+				Message: `Pod "web" is invalid: spec.containers: Required value: at least one container`,
+				Kind:    "Pod",
+				Name:    "web",
+				Causes:  []ErrorCause{{Type: "FieldValueRequired", Message: "Required value: at least one container", Field: "spec.containers"}},
+			},
+		},
+		{
+			name: "too many requests",
+			err:  apierrors.NewTooManyRequests("slow down", 5),
+			want: ErrorResponse{Code: http.StatusTooManyRequests, Reason: "TooManyRequests", Message: "slow down", RetryAfterSeconds: 5},
+		},
+		{
+			name: "code without reason",
+			err:  &apierrors.StatusError{ErrStatus: metav1.Status{Code: http.StatusNotFound, Message: "gone"}},
+			want: ErrorResponse{Code: http.StatusNotFound, Reason: "NotFound", Message: "gone"},
+		},
+		{
+			name: "neither code nor reason",
+			err:  &apierrors.StatusError{ErrStatus: metav1.Status{Message: "unknown"}},
+			want: ErrorResponse{Code: http.StatusInternalServerError, Reason: "InternalError", Message: "unknown"},
+		},
+		{
+			name: "deadline exceeded",
+			err:  fmt.Errorf("waiting: %w", context.DeadlineExceeded),
+			want: ErrorResponse{Code: http.StatusGatewayTimeout, Reason: "Timeout", Message: "waiting: context deadline exceeded"},
+		},
+		{
+			name: "plain error",
+			err:  errors.New("boom"),
+			want: ErrorResponse{Code: http.StatusInternalServerError, Reason: "InternalError", Message: "boom"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := NewErrorResponse(test.err); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestErrorSetsRetryAfter(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	Error(ctx, apierrors.NewTooManyRequests("slow down", 7))
+	if recorder.Code != http.StatusTooManyRequests {
+		t.Errorf("got status %d, want %d", recorder.Code, http.StatusTooManyRequests)
+	}
+	if got := recorder.Header().Get("Retry-After"); got != "7" {
+		t.Errorf("got Retry-After %q, want 7", got)
+	}
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"strconv"
+)
+
+// ListQuery holds the validated filtering and pagination parameters of a list request.
+type ListQuery struct {
+	Options metav1.ListOptions
+	Labels  labels.Selector
+}
+
+// ParseListQuery reads labelSelector, fieldSelector, limit and continue, validating
+// the selectors before they reach the apiserver.
+func ParseListQuery(ctx *gin.Context) (ListQuery, error) {
+	query := ListQuery{Labels: labels.Everything()}
+	if value := ctx.Query("labelSelector"); value != "" {
+		selector, err := labels.Parse(value)
+		if err != nil {
+			return query, fmt.Errorf("invalid labelSelector: %w", err)
+		}
+		query.Labels = selector
+		query.Options.LabelSelector = selector.String()
+	}
+	if value := ctx.Query("fieldSelector"); value != "" {
+		selector, err := fields.ParseSelector(value)
+		if err != nil {
+			return query, fmt.Errorf("invalid fieldSelector: %w", err)
+		}
+		query.Options.FieldSelector = selector.String()
+	}
+	if value := ctx.Query("limit"); value != "" {
+		limit, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || limit < 0 {
+			return query, fmt.Errorf("limit must be a non-negative integer, got %q", value)
+		}
+		query.Options.Limit = limit
+	}
+	query.Options.Continue = ctx.Query("continue")
+	if query.Options.Continue != "" && query.Options.Limit == 0 {
+		return query, fmt.Errorf("continue requires limit")
+	}
+	return query, nil
+}
+
+// Cacheable reports whether the query can be answered by an informer cache,
+// which supports label selectors but neither field selectors nor pagination.
+func (q ListQuery) Cacheable() bool {
+	return q.Options.FieldSelector == "" && q.Options.Limit == 0 && q.Options.Continue == ""
+}
+
+// WatchOptions returns the options used to watch the listed objects; pagination
+// does not apply to watches.
+func (q ListQuery) WatchOptions() metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: q.Options.LabelSelector,
+		FieldSelector: q.Options.FieldSelector,
+	}
+}
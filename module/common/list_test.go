@@ -0,0 +1,121 @@
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestParseListQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		want          metav1.ListOptions
+		wantLabels    string
+		wantCacheable bool
+		wantErr       string
+	}{
+		{
+			name:          "empty",
+			want:          metav1.ListOptions{},
+			wantCacheable: true,
+		},
+		{
+			name:          "label selector",
+			query:         "labelSelector=app%3Dweb,tier!%3Dcache",
+			want:          metav1.ListOptions{LabelSelector: "app=web,tier!=cache"},
+			wantLabels:    "app=web,tier!=cache",
+			wantCacheable: true,
+		},
+		{
+			name:  "field selector",
+			query: "fieldSelector=status.phase%3DRunning",
+			want:  metav1.ListOptions{FieldSelector: "status.phase=Running"},
+		},
+		{
+			name:  "pagination",
+			query: "limit=10&continue=token",
+			want:  metav1.ListOptions{Limit: 10, Continue: "token"},
+		},
+		{
+			name:          "zero limit",
+			query:         "limit=0",
+			want:          metav1.ListOptions{},
+			wantCacheable: true,
+		},
+		{
+			name:    "invalid label selector",
+			query:   "labelSelector=app%3D%3D%3Dweb",
+			wantErr: "invalid labelSelector",
+		},
+		{
+			name:    "invalid field selector",
+			query:   "fieldSelector=status.phase",
+			wantErr: "invalid fieldSelector",
+		},
+		{
+			name:    "negative limit",
+			query:   "limit=-1",
+			wantErr: "limit must be a non-negative integer",
+		},
+		{
+			name:    "non-numeric limit",
+			query:   "limit=ten",
+			wantErr: "limit must be a non-negative integer",
+		},
+		{
+			name:    "continue without limit",
+			query:   "continue=token",
+			wantErr: "continue requires limit",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/?"+test.query, nil)
+			query, err := ParseListQuery(ctx)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(query.Options, test.want) {
+				t.Errorf("got options %+v, want %+v", query.Options, test.want)
+			}
+			if got := query.Labels.String(); got != test.wantLabels {
+				t.Errorf("got labels %q, want %q", got, test.wantLabels)
+			}
+			if got := query.Cacheable(); got != test.wantCacheable {
+				t.Errorf("got cacheable %v, want %v", got, test.wantCacheable)
+			}
+			watch := query.WatchOptions()
+			if watch.Limit != 0 || watch.Continue != "" || watch.LabelSelector != test.want.LabelSelector || watch.FieldSelector != test.want.FieldSelector {
+				t.Errorf("got watch options %+v", watch)
+			}
+		})
+	}
+}
+
+func TestParseListQueryLabelsMatch(t *testing.T) {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/?labelSelector=app%20in%20(web,api)", nil)
+	query, err := ParseListQuery(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for value, want := range map[string]bool{"web": true, "api": true, "db": false} {
+		if got := query.Labels.Matches(labels.Set{"app": value}); got != want {
+			t.Errorf("app=%s: got match %v, want %v", value, got, want)
+		}
+	}
+}
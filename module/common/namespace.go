@@ -0,0 +1,19 @@
+package common
+
+import (
+	"github.com/gin-gonic/gin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AllNamespaces is the reserved :namespace value selecting every namespace on list endpoints.
+const AllNamespaces = "_all"
+
+// NamespaceParam returns the :namespace path parameter, translating
+// AllNamespaces to metav1.NamespaceAll.
+func NamespaceParam(ctx *gin.Context) string {
+	namespace := ctx.Param("namespace")
+	if namespace == AllNamespaces {
+		return metav1.NamespaceAll
+	}
+	return namespace
+}
@@ -0,0 +1,77 @@
+package common
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"mime"
+	"strconv"
+)
+
+// DefaultFieldManager is used for server-side apply when the client does not name one.
+const DefaultFieldManager = "go-kubernetes"
+
+var patchTypes = map[string]types.PatchType{
+	string(types.JSONPatchType):           types.JSONPatchType,
+	string(types.MergePatchType):          types.MergePatchType,
+	string(types.StrategicMergePatchType): types.StrategicMergePatchType,
+	string(types.ApplyPatchType):          types.ApplyPatchType,
+}
+
+// Patch is a patch request decoded from the Content-Type, body and query parameters.
+type Patch struct {
+	Type    types.PatchType
+	Data    []byte
+	Options metav1.PatchOptions
+}
+
+// DecodePatch reads a patch request. The patch type is chosen from the
+// Content-Type; fieldManager, force and dryRun are read from the query.
+func DecodePatch(ctx *gin.Context) (*Patch, error) {
+	mediaType, _, err := mime.ParseMediaType(ctx.ContentType())
+	patchType, ok := patchTypes[mediaType]
+	if err != nil || !ok {
+		return nil, UnsupportedMediaType(ctx.ContentType(),
+			string(types.JSONPatchType),
+			string(types.MergePatchType),
+			string(types.StrategicMergePatchType),
+			string(types.ApplyPatchType),
+		)
+	}
+	dryRun, err := DryRun(ctx)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	options := metav1.PatchOptions{
+		DryRun:       dryRun,
+		FieldManager: ctx.Query("fieldManager"),
+	}
+	if value := ctx.Query("force"); value != "" {
+		force, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid force value: %v", err))
+		}
+		if patchType != types.ApplyPatchType {
+			return nil, apierrors.NewBadRequest("force is only supported for apply patches")
+		}
+		options.Force = &force
+	}
+	if patchType == types.ApplyPatchType && options.FieldManager == "" {
+		options.FieldManager = DefaultFieldManager
+	}
+
+	data, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	if len(data) > maxManifestSize {
+		return nil, apierrors.NewRequestEntityTooLargeError(fmt.Sprintf("patch exceeds %d bytes", maxManifestSize))
+	}
+	if len(data) == 0 {
+		return nil, apierrors.NewBadRequest("patch body must not be empty")
+	}
+	return &Patch{Type: patchType, Data: data, Options: options}, nil
+}
@@ -0,0 +1,46 @@
+package common
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
+
+// WantsEventStream reports whether the client asked for Server-Sent Events,
+// either through the Accept header or with ?format=sse.
+func WantsEventStream(ctx *gin.Context) bool {
+	if ctx.Query("format") == "sse" {
+		return true
+	}
+	return strings.Contains(ctx.GetHeader("Accept"), "text/event-stream")
+}
+
+// StartEventStream writes the headers of a Server-Sent Events response.
+func StartEventStream(ctx *gin.Context) {
+	header := ctx.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush()
+}
+
+// SendEvent writes a single event and flushes it to the client. Strings are
+// sent as is, anything else is encoded as JSON.
+func SendEvent(ctx *gin.Context, event string, data any) {
+	ctx.SSEvent(event, data)
+	ctx.Writer.Flush()
+}
+
+// SendComment writes an SSE comment, used as a heartbeat to keep idle connections open.
+func SendComment(ctx *gin.Context, comment string) {
+	_, _ = fmt.Fprintf(ctx.Writer, ": %s\n\n", comment)
+	ctx.Writer.Flush()
+}
+
+// StreamError reports err on an already started event stream.
+func StreamError(ctx *gin.Context, err error) {
+	SendEvent(ctx, "error", NewErrorResponse(err))
+}
@@ -0,0 +1,159 @@
+package common
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"strconv"
+	"time"
+)
+
+const (
+	heartbeatInterval = 15 * time.Second
+	// watchRetryDelay is the pause before re-listing after a failed watch.
+	watchRetryDelay = time.Second
+)
+
+// SyncEvent is sent when the stream starts and after every re-list. Clients
+// should replace their state with Items.
+const SyncEvent = "SYNC"
+
+// ListWatch lists and watches a single kind of object with the given options.
+type ListWatch struct {
+	List  func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error)
+	Watch func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error)
+	// Transform, when set, converts the listed and watched objects before they
+	// are sent, e.g. to redact them.
+	Transform func(obj runtime.Object) any
+}
+
+// payload returns the data sent to the client for obj.
+func (lw ListWatch) payload(obj runtime.Object) any {
+	if lw.Transform == nil {
+		return obj
+	}
+	return lw.Transform(obj)
+}
+
+// WantsWatch reports whether a list request asked for ?watch=true.
+func WantsWatch(ctx *gin.Context) (bool, error) {
+	value := ctx.Query("watch")
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// StreamWatch serves a list endpoint as a Server-Sent Events stream. It sends
+// the current list as a SYNC event, then every ADDED, MODIFIED and DELETED
+// event from a watch started at the list's resourceVersion. The list is
+// fetched again when the resourceVersion expires (410 Gone), and the stream
+// ends when the client disconnects.
+func StreamWatch(ctx *gin.Context, lw ListWatch, options metav1.ListOptions) {
+	requestCtx := ctx.Request.Context()
+	resourceVersion, err := syncList(ctx, lw, options, false)
+	if err != nil {
+		Error(ctx, err)
+		return
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		watchOptions := options
+		watchOptions.ResourceVersion = resourceVersion
+		watchOptions.AllowWatchBookmarks = true
+		watcher, err := lw.Watch(requestCtx, watchOptions)
+		if err != nil {
+			if requestCtx.Err() != nil {
+				return
+			}
+			if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+				if resourceVersion, err = relist(ctx, lw, options); err != nil {
+					return
+				}
+				continue
+			}
+			StreamError(ctx, err)
+			return
+		}
+		resourceVersion, err = forwardEvents(ctx, lw, watcher, heartbeat, resourceVersion)
+		watcher.Stop()
+		if requestCtx.Err() != nil {
+			return
+		}
+		if err != nil {
+			if resourceVersion, err = relist(ctx, lw, options); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// forwardEvents copies watch events to the client until the watch closes. It
+// returns the last seen resourceVersion and a non-nil error when a re-list is needed.
+func forwardEvents(ctx *gin.Context, lw ListWatch, watcher watch.Interface, heartbeat *time.Ticker, resourceVersion string) (string, error) {
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return resourceVersion, nil
+		case <-heartbeat.C:
+			SendComment(ctx, "heartbeat")
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return resourceVersion, nil
+			}
+			switch event.Type {
+			case watch.Error:
+				return resourceVersion, apierrors.FromObject(event.Object)
+			case watch.Bookmark:
+				if accessor, err := meta.Accessor(event.Object); err == nil {
+					resourceVersion = accessor.GetResourceVersion()
+				}
+			default:
+				if accessor, err := meta.Accessor(event.Object); err == nil {
+					resourceVersion = accessor.GetResourceVersion()
+				}
+				SendEvent(ctx, string(event.Type), lw.payload(event.Object))
+			}
+		}
+	}
+}
+
+// relist waits briefly and sends a fresh SYNC event after the watch failed.
+func relist(ctx *gin.Context, lw ListWatch, options metav1.ListOptions) (string, error) {
+	select {
+	case <-ctx.Request.Context().Done():
+		return "", ctx.Request.Context().Err()
+	case <-time.After(watchRetryDelay):
+	}
+	return syncList(ctx, lw, options, true)
+}
+
+// syncList lists the objects and sends them as a SYNC event, starting the
+// event stream on the first call.
+func syncList(ctx *gin.Context, lw ListWatch, options metav1.ListOptions, started bool) (string, error) {
+	list, err := lw.List(ctx.Request.Context(), options)
+	if err != nil {
+		if started {
+			StreamError(ctx, err)
+		}
+		return "", err
+	}
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil {
+		if started {
+			StreamError(ctx, err)
+		}
+		return "", err
+	}
+	if !started {
+		StartEventStream(ctx)
+	}
+	SendEvent(ctx, SyncEvent, lw.payload(list))
+	return listMeta.GetResourceVersion(), nil
+}
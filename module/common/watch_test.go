@@ -0,0 +1,144 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func newTestWatchContext(ctx context.Context) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	ginCtx, _ := gin.CreateTestContext(recorder)
+	ginCtx.Request = httptest.NewRequest(http.MethodGet, "/pods?watch=true", nil).WithContext(ctx)
+	return ginCtx, recorder
+}
+
+func newTestPod(name, resourceVersion string) *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: resourceVersion}}
+}
+
+func TestStreamWatch(t *testing.T) {
+	expired := apierrors.NewResourceExpired("too old resource version: 1 (4)")
+	tests := []struct {
+		name string
+		// watchErr fails the first watch request, otherwise the watch ends
+		// with an expired event.
+		watchErr error
+	}{
+		{name: "expired watch event"},
+		{name: "expired watch request", watchErr: expired},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requestCtx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			ctx, recorder := newTestWatchContext(requestCtx)
+
+			lists, watchCalls := 0, 0
+			watches := make(chan string, 2)
+			watchers := []*watch.FakeWatcher{watch.NewFake(), watch.NewFake()}
+			lw := ListWatch{
+				List: func(_ context.Context, options metav1.ListOptions) (runtime.Object, error) {
+					if options.ResourceVersion != "" {
+						t.Errorf("got list resourceVersion %q, want a fresh list", options.ResourceVersion)
+					}
+					lists++
+					list := &v1.PodList{Items: []v1.Pod{*newTestPod("web-a", "1")}}
+					list.ResourceVersion = []string{"1", "5"}[lists-1]
+					return list, nil
+				},
+				Watch: func(_ context.Context, options metav1.ListOptions) (watch.Interface, error) {
+					index := watchCalls
+					watchCalls++
+					watches <- options.ResourceVersion
+					if index == 0 && test.watchErr != nil {
+						return nil, test.watchErr
+					}
+					return watchers[index], nil
+				},
+			}
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				StreamWatch(ctx, lw, metav1.ListOptions{LabelSelector: "app=web"})
+			}()
+			if got := <-watches; got != "1" {
+				t.Errorf("got first watch resourceVersion %q, want 1", got)
+			}
+			if test.watchErr == nil {
+				watchers[0].Add(newTestPod("web-b", "2"))
+				watchers[0].Error(&expired.ErrStatus)
+			}
+			select {
+			case got := <-watches:
+				if got != "5" {
+					t.Errorf("got resourceVersion %q after the re-list, want 5", got)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("the stream did not re-list and watch again")
+			}
+			cancel()
+			<-done
+
+			body := recorder.Body.String()
+			if got := strings.Count(body, "event:"+SyncEvent); got != 2 {
+				t.Errorf("got %d SYNC events, want 2: %s", got, body)
+			}
+			if test.watchErr == nil && !strings.Contains(body, "event:ADDED") {
+				t.Errorf("the ADDED event before the expiry was not sent: %s", body)
+			}
+			if strings.Contains(body, "event:error") {
+				t.Errorf("an expired resourceVersion was reported to the client: %s", body)
+			}
+		})
+	}
+}
+
+func TestForwardEvents(t *testing.T) {
+	ctx, recorder := newTestWatchContext(context.Background())
+	watcher := watch.NewFake()
+	heartbeat := time.NewTicker(10 * time.Millisecond)
+	defer heartbeat.Stop()
+
+	type result struct {
+		resourceVersion string
+		err             error
+	}
+	done := make(chan result)
+	go func() {
+		resourceVersion, err := forwardEvents(ctx, ListWatch{}, watcher, heartbeat, "1")
+		done <- result{resourceVersion, err}
+	}()
+	watcher.Modify(newTestPod("web-a", "3"))
+	watcher.Action(watch.Bookmark, newTestPod("", "7"))
+	// Leave the watch idle long enough for a heartbeat.
+	time.Sleep(50 * time.Millisecond)
+	watcher.Stop()
+	got := <-done
+
+	if got.err != nil {
+		t.Fatalf("unexpected error: %v", got.err)
+	}
+	if got.resourceVersion != "7" {
+		t.Errorf("got resourceVersion %q, want the bookmark's 7", got.resourceVersion)
+	}
+	body := recorder.Body.String()
+	if !strings.Contains(body, ": heartbeat\n\n") {
+		t.Errorf("no heartbeat was sent: %s", body)
+	}
+	if !strings.Contains(body, "event:MODIFIED") || strings.Contains(body, "event:BOOKMARK") {
+		t.Errorf("got %s, want the MODIFIED event and no BOOKMARK", body)
+	}
+}
@@ -0,0 +1,329 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config holds the startup configuration of the server. Every flag can also be
+// provided through the environment variable listed next to it.
+type Config struct {
+	// Address the HTTP server listens on (SERVER_ADDRESS).
+	Address string
+	// InCluster forces rest.InClusterConfig instead of a kubeconfig file (K8S_IN_CLUSTER).
+	InCluster bool
+	// Kubeconfig is an explicit kubeconfig path. When empty the KUBECONFIG
+	// variable is honoured and its files are merged, falling back to ~/.kube/config.
+	Kubeconfig string
+	// Context selects a named kubeconfig context instead of the current one (K8S_CONTEXT).
+	Context string
+	// QPS and Burst configure client side rate limiting (K8S_QPS, K8S_BURST).
+	QPS   float64
+	Burst int
+	// Timeout bounds every unary request made to the apiserver; watches,
+	// followed logs and exec sessions are not bounded (K8S_TIMEOUT).
+	Timeout time.Duration
+	// Clusters is a comma separated list of additional kubeconfig contexts
+	// served under /clusters/{name}. Entries are either "context" or
+	// "name=context" (K8S_CLUSTERS).
+	Clusters string
+	// Cache enables the informer backed read cache (K8S_CACHE).
+	Cache bool
+	// CacheResync is the resync period of the cache informers (K8S_CACHE_RESYNC).
+	CacheResync time.Duration
+	// CacheSyncTimeout bounds the initial sync of the cache; reads are served
+	// from the apiserver when it is exceeded (K8S_CACHE_SYNC_TIMEOUT).
+	CacheSyncTimeout time.Duration
+	// Reaper enables the deletion of expired namespaces (K8S_REAPER).
+	Reaper bool
+	// ReaperInterval is the time between two reaper passes (K8S_REAPER_INTERVAL).
+	ReaperInterval time.Duration
+	// ReaperLeaseNamespace holds the Lease the reaper replicas elect a leader
+	// with (K8S_REAPER_LEASE_NAMESPACE).
+	ReaperLeaseNamespace string
+	// SecretRevealToken authorizes reading secret values with reveal=true. It
+	// is sent in the X-Secret-Reveal-Token header; revealing is disabled when
+	// empty. It is only read from K8S_SECRET_REVEAL_TOKEN or from the file
+	// named by SecretRevealTokenFile, never from a flag visible in the process
+	// arguments.
+	SecretRevealToken string
+	// SecretRevealTokenFile holds the reveal token, e.g. a mounted Secret
+	// (K8S_SECRET_REVEAL_TOKEN_FILE).
+	SecretRevealTokenFile string
+}
+
+// ClusterContext maps a cluster name used in URLs to a kubeconfig context.
+type ClusterContext struct {
+	Name    string
+	Context string
+}
+
+// Load parses args on top of the environment and returns the resulting configuration.
+func Load(args []string) (*Config, error) {
+	cfg := &Config{}
+	inCluster, err := envBool("K8S_IN_CLUSTER", false)
+	if err != nil {
+		return nil, err
+	}
+	qps, err := envFloat("K8S_QPS", float64(rest.DefaultQPS))
+	if err != nil {
+		return nil, err
+	}
+	burst, err := envInt("K8S_BURST", rest.DefaultBurst)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := envDuration("K8S_TIMEOUT", 0)
+	if err != nil {
+		return nil, err
+	}
+	cacheEnabled, err := envBool("K8S_CACHE", true)
+	if err != nil {
+		return nil, err
+	}
+	cacheResync, err := envDuration("K8S_CACHE_RESYNC", 10*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	cacheSyncTimeout, err := envDuration("K8S_CACHE_SYNC_TIMEOUT", 2*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	reaper, err := envBool("K8S_REAPER", false)
+	if err != nil {
+		return nil, err
+	}
+	reaperInterval, err := envDuration("K8S_REAPER_INTERVAL", time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := flag.NewFlagSet("go-kubernetes", flag.ContinueOnError)
+	fs.StringVar(&cfg.Address, "address", envString("SERVER_ADDRESS", ":8080"), "address the HTTP server listens on")
+	fs.BoolVar(&cfg.InCluster, "in-cluster", inCluster, "use the service account of the pod the server runs in")
+	fs.StringVar(&cfg.Kubeconfig, "kubeconfig", "", "path to a kubeconfig file, defaults to $KUBECONFIG or ~/.kube/config")
+	fs.StringVar(&cfg.Context, "context", envString("K8S_CONTEXT", ""), "kubeconfig context to use, defaults to the current context")
+	fs.Float64Var(&cfg.QPS, "qps", qps, "maximum queries per second sent to the apiserver")
+	fs.IntVar(&cfg.Burst, "burst", burst, "maximum burst of queries sent to the apiserver")
+	fs.DurationVar(&cfg.Timeout, "timeout", timeout, "timeout of a single apiserver request, 0 means no timeout; watches, followed logs and exec are not bounded")
+	fs.StringVar(&cfg.Clusters, "clusters", envString("K8S_CLUSTERS", ""), "comma separated kubeconfig contexts to serve, as context or name=context")
+	fs.BoolVar(&cfg.Cache, "cache", cacheEnabled, "serve list and get requests from an informer cache")
+	fs.DurationVar(&cfg.CacheResync, "cache-resync", cacheResync, "resync period of the informer cache")
+	fs.DurationVar(&cfg.CacheSyncTimeout, "cache-sync-timeout", cacheSyncTimeout, "time to wait for the initial cache sync before serving reads from the apiserver")
+	fs.BoolVar(&cfg.Reaper, "reaper", reaper, "delete namespaces whose ttl or expires-at annotation has passed")
+	fs.DurationVar(&cfg.ReaperInterval, "reaper-interval", reaperInterval, "time between two passes of the namespace reaper")
+	fs.StringVar(&cfg.ReaperLeaseNamespace, "reaper-lease-namespace", envString("K8S_REAPER_LEASE_NAMESPACE", "default"), "namespace of the Lease used to elect the reaper leader")
+	fs.StringVar(&cfg.SecretRevealTokenFile, "secret-reveal-token-file", envString("K8S_SECRET_REVEAL_TOKEN_FILE", ""), "file holding the token required in the X-Secret-Reveal-Token header to reveal secret values")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if cfg.SecretRevealToken, err = revealToken(cfg.SecretRevealTokenFile); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// revealToken reads the secret reveal token from file, or from
+// K8S_SECRET_REVEAL_TOKEN when file is empty.
+func revealToken(file string) (string, error) {
+	token := envString("K8S_SECRET_REVEAL_TOKEN", "")
+	if file == "" {
+		return token, nil
+	}
+	if token != "" {
+		return "", errors.New("K8S_SECRET_REVEAL_TOKEN cannot be combined with --secret-reveal-token-file")
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("reading --secret-reveal-token-file: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// Validate reports options that cannot be combined or are out of range.
+func (c *Config) Validate() error {
+	if c.InCluster && (c.Kubeconfig != "" || c.Context != "") {
+		return errors.New("--in-cluster cannot be combined with --kubeconfig or --context")
+	}
+	if c.InCluster && c.Clusters != "" {
+		return errors.New("--in-cluster cannot be combined with --clusters")
+	}
+	if _, err := c.ClusterContexts(); err != nil {
+		return err
+	}
+	if c.QPS < 0 {
+		return fmt.Errorf("--qps must not be negative, got %v", c.QPS)
+	}
+	if c.Burst < 0 {
+		return fmt.Errorf("--burst must not be negative, got %d", c.Burst)
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("--timeout must not be negative, got %s", c.Timeout)
+	}
+	if c.CacheResync < 0 {
+		return fmt.Errorf("--cache-resync must not be negative, got %s", c.CacheResync)
+	}
+	if c.Cache && c.CacheSyncTimeout <= 0 {
+		return fmt.Errorf("--cache-sync-timeout must be positive, got %s", c.CacheSyncTimeout)
+	}
+	if c.Reaper && c.ReaperInterval <= 0 {
+		return fmt.Errorf("--reaper-interval must be positive, got %s", c.ReaperInterval)
+	}
+	if c.Reaper && c.ReaperLeaseNamespace == "" {
+		return errors.New("--reaper-lease-namespace must not be empty")
+	}
+	return nil
+}
+
+// ClusterContexts parses the --clusters list.
+func (c *Config) ClusterContexts() ([]ClusterContext, error) {
+	var contexts []ClusterContext
+	seen := map[string]bool{}
+	for _, entry := range strings.Split(c.Clusters, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, context, found := strings.Cut(entry, "=")
+		if !found {
+			context = name
+		}
+		name, context = strings.TrimSpace(name), strings.TrimSpace(context)
+		if name == "" || context == "" {
+			return nil, fmt.Errorf("invalid --clusters entry %q, expected context or name=context", entry)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate cluster name %q in --clusters", name)
+		}
+		seen[name] = true
+		contexts = append(contexts, ClusterContext{Name: name, Context: context})
+	}
+	return contexts, nil
+}
+
+// DefaultClusterName returns the name the default cluster is registered under:
+// the selected kubeconfig context, or "in-cluster" for the service account config.
+func (c *Config) DefaultClusterName() string {
+	if c.InCluster {
+		return "in-cluster"
+	}
+	if c.Context != "" {
+		return c.Context
+	}
+	rawConfig, err := c.clientConfig("").RawConfig()
+	if err != nil || rawConfig.CurrentContext == "" {
+		return "default"
+	}
+	return rawConfig.CurrentContext
+}
+
+// RestConfig builds the client configuration used to reach the default cluster.
+// Without --in-cluster the kubeconfig loading rules are used, which still fall
+// back to the in-cluster service account when no kubeconfig can be found.
+func (c *Config) RestConfig() (*rest.Config, error) {
+	if c.InCluster {
+		restConfig, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("loading in-cluster config: %w", err)
+		}
+		return c.withClientOptions(restConfig), nil
+	}
+	return c.RestConfigForContext(c.Context)
+}
+
+// RestConfigForContext builds the client configuration of a named kubeconfig context.
+func (c *Config) RestConfigForContext(context string) (*rest.Config, error) {
+	restConfig, err := c.clientConfig(context).ClientConfig()
+	if err != nil {
+		if context != "" {
+			return nil, fmt.Errorf("loading kubeconfig context %q: %w", context, err)
+		}
+		return nil, fmt.Errorf("loading kubeconfig: %w", err)
+	}
+	return c.withClientOptions(restConfig), nil
+}
+
+func (c *Config) withClientOptions(restConfig *rest.Config) *rest.Config {
+	restConfig.QPS = float32(c.QPS)
+	restConfig.Burst = c.Burst
+	if c.Timeout > 0 {
+		timeout := c.Timeout
+		restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return &timeoutTransport{next: rt, timeout: timeout}
+		})
+	}
+	return restConfig
+}
+
+func (c *Config) clientConfig(context string) clientcmd.ClientConfig {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = c.Kubeconfig
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: context}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
+}
+
+func envString(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
+func envBool(key string, fallback bool) (bool, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return parsed, nil
+}
+
+func envInt(key string, fallback int) (int, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return parsed, nil
+}
+
+func envFloat(key string, fallback float64) (float64, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback, nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return parsed, nil
+}
+
+func envDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback, nil
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,208 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var envKeys = []string{
+	"SERVER_ADDRESS", "K8S_IN_CLUSTER", "K8S_CONTEXT", "K8S_QPS", "K8S_BURST", "K8S_TIMEOUT",
+	"K8S_CLUSTERS", "K8S_CACHE", "K8S_CACHE_RESYNC", "K8S_CACHE_SYNC_TIMEOUT", "K8S_REAPER",
+	"K8S_REAPER_INTERVAL", "K8S_REAPER_LEASE_NAMESPACE", "K8S_SECRET_REVEAL_TOKEN", "K8S_SECRET_REVEAL_TOKEN_FILE",
+}
+
+// setTestEnv unsets every variable Load reads, then sets env. The previous
+// values are restored when the test ends.
+func setTestEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+	for _, key := range envKeys {
+		t.Setenv(key, "")
+		if err := os.Unsetenv(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for key, value := range env {
+		t.Setenv(key, value)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("s3cret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		env     map[string]string
+		args    []string
+		check   func(cfg *Config) bool
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			check: func(cfg *Config) bool {
+				return cfg.Address == ":8080" && cfg.QPS == 5 && cfg.Burst == 10 && cfg.Timeout == 0 &&
+					cfg.Cache && cfg.CacheResync == 10*time.Minute && !cfg.Reaper && cfg.ReaperLeaseNamespace == "default"
+			},
+		},
+		{
+			name: "environment",
+			env:  map[string]string{"SERVER_ADDRESS": ":9090", "K8S_QPS": "50", "K8S_BURST": "100", "K8S_TIMEOUT": "30s", "K8S_CACHE": "false"},
+			check: func(cfg *Config) bool {
+				return cfg.Address == ":9090" && cfg.QPS == 50 && cfg.Burst == 100 && cfg.Timeout == 30*time.Second && !cfg.Cache
+			},
+		},
+		{
+			name: "flags override the environment",
+			env:  map[string]string{"K8S_QPS": "50", "K8S_TIMEOUT": "30s", "K8S_CONTEXT": "staging"},
+			args: []string{"--qps=20", "--timeout=1m", "--context=prod"},
+			check: func(cfg *Config) bool {
+				return cfg.QPS == 20 && cfg.Timeout == time.Minute && cfg.Context == "prod"
+			},
+		},
+		{
+			name:  "empty variables keep the default",
+			env:   map[string]string{"K8S_QPS": "", "K8S_CACHE": ""},
+			check: func(cfg *Config) bool { return cfg.QPS == 5 && cfg.Cache },
+		},
+		{
+			name:  "reveal token file",
+			args:  []string{"--secret-reveal-token-file=" + tokenFile},
+			check: func(cfg *Config) bool { return cfg.SecretRevealToken == "s3cret" },
+		},
+		{
+			name:    "invalid variable",
+			env:     map[string]string{"K8S_BURST": "many"},
+			wantErr: "invalid K8S_BURST",
+		},
+		{
+			name:    "invalid timeout variable",
+			env:     map[string]string{"K8S_TIMEOUT": "30"},
+			wantErr: "invalid K8S_TIMEOUT",
+		},
+		{
+			name:    "invalid flag",
+			args:    []string{"--qps=fast"},
+			wantErr: "invalid value",
+		},
+		{
+			name:    "negative qps flag",
+			args:    []string{"--qps=-1"},
+			wantErr: "--qps must not be negative",
+		},
+		{
+			name:    "negative burst variable",
+			env:     map[string]string{"K8S_BURST": "-5"},
+			wantErr: "--burst must not be negative",
+		},
+		{
+			name:    "reveal token variable and file",
+			env:     map[string]string{"K8S_SECRET_REVEAL_TOKEN": "token"},
+			args:    []string{"--secret-reveal-token-file=" + tokenFile},
+			wantErr: "cannot be combined",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setTestEnv(t, test.env)
+			cfg, err := Load(test.args)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.check(cfg) {
+				t.Errorf("unexpected configuration %+v", cfg)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() Config {
+		return Config{QPS: 5, Burst: 10, Cache: true, CacheSyncTimeout: time.Minute, ReaperInterval: time.Minute, ReaperLeaseNamespace: "default"}
+	}
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{name: "valid", modify: func(*Config) {}},
+		{name: "zero qps and burst", modify: func(cfg *Config) { cfg.QPS, cfg.Burst = 0, 0 }},
+		{name: "negative qps", modify: func(cfg *Config) { cfg.QPS = -0.5 }, wantErr: "--qps"},
+		{name: "negative burst", modify: func(cfg *Config) { cfg.Burst = -1 }, wantErr: "--burst"},
+		{name: "negative timeout", modify: func(cfg *Config) { cfg.Timeout = -time.Second }, wantErr: "--timeout"},
+		{name: "negative cache resync", modify: func(cfg *Config) { cfg.CacheResync = -time.Second }, wantErr: "--cache-resync"},
+		{name: "zero cache sync timeout", modify: func(cfg *Config) { cfg.CacheSyncTimeout = 0 }, wantErr: "--cache-sync-timeout"},
+		{name: "zero cache sync timeout without cache", modify: func(cfg *Config) { cfg.Cache, cfg.CacheSyncTimeout = false, 0 }},
+		{name: "zero reaper interval", modify: func(cfg *Config) { cfg.Reaper, cfg.ReaperInterval = true, 0 }, wantErr: "--reaper-interval"},
+		{name: "empty reaper lease namespace", modify: func(cfg *Config) { cfg.Reaper, cfg.ReaperLeaseNamespace = true, "" }, wantErr: "--reaper-lease-namespace"},
+		{name: "in-cluster with context", modify: func(cfg *Config) { cfg.InCluster, cfg.Context = true, "prod" }, wantErr: "--in-cluster"},
+		{name: "in-cluster with clusters", modify: func(cfg *Config) { cfg.InCluster, cfg.Clusters = true, "prod" }, wantErr: "--clusters"},
+		{name: "invalid clusters", modify: func(cfg *Config) { cfg.Clusters = "=prod" }, wantErr: "invalid --clusters entry"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := valid()
+			test.modify(&cfg)
+			err := cfg.Validate()
+			if test.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("got error %v, want %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestClusterContexts(t *testing.T) {
+	tests := []struct {
+		name     string
+		clusters string
+		want     []ClusterContext
+		wantErr  string
+	}{
+		{name: "empty"},
+		{
+			name:     "contexts and names",
+			clusters: "staging, prod=gke_prod_europe , ,dev = kind-dev",
+			want: []ClusterContext{
+				{Name: "staging", Context: "staging"},
+				{Name: "prod", Context: "gke_prod_europe"},
+				{Name: "dev", Context: "kind-dev"},
+			},
+		},
+		{name: "missing name", clusters: "=prod", wantErr: "invalid --clusters entry"},
+		{name: "missing context", clusters: "prod=", wantErr: "invalid --clusters entry"},
+		{name: "duplicate name", clusters: "prod=a,prod=b", wantErr: "duplicate cluster name"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := (&Config{Clusters: test.clusters}).ClusterContexts()
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
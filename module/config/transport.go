@@ -0,0 +1,60 @@
+package config
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeoutTransport bounds every unary apiserver request with its own context
+// deadline. Watches, followed logs and upgraded connections such as exec are
+// long-lived streams and are left unbounded; rest.Config.Timeout cannot tell
+// them apart since it becomes the timeout of the whole http.Client.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isStream(req) {
+		return t.next.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// The deadline also covers reading the body, released once it is closed.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// isStream reports whether req opens a watch, a followed log or an upgraded
+// connection.
+func isStream(req *http.Request) bool {
+	if req.Header.Get("Upgrade") != "" {
+		return true
+	}
+	query := req.URL.Query()
+	if watch, _ := strconv.ParseBool(query.Get("watch")); watch {
+		return true
+	}
+	if follow, _ := strconv.ParseBool(query.Get("follow")); follow && strings.HasSuffix(req.URL.Path, "/log") {
+		return true
+	}
+	return false
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
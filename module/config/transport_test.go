@@ -0,0 +1,82 @@
+package config
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTimeoutTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		upgrade      string
+		wantDeadline bool
+	}{
+		{name: "get", url: "/api/v1/namespaces/default/pods/web", wantDeadline: true},
+		{name: "list", url: "/api/v1/namespaces/default/pods?limit=10", wantDeadline: true},
+		{name: "watch", url: "/api/v1/namespaces/default/pods?watch=true&resourceVersion=1"},
+		{name: "watch disabled", url: "/api/v1/namespaces/default/pods?watch=false", wantDeadline: true},
+		{name: "followed log", url: "/api/v1/namespaces/default/pods/web/log?follow=true&container=app"},
+		{name: "log", url: "/api/v1/namespaces/default/pods/web/log?container=app", wantDeadline: true},
+		{name: "follow outside of logs", url: "/api/v1/namespaces/default/pods/web?follow=true", wantDeadline: true},
+		{name: "exec upgrade", url: "/api/v1/namespaces/default/pods/web/exec?command=sh", upgrade: "SPDY/3.1"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var hasDeadline bool
+			var requestErr func() error
+			transport := &timeoutTransport{
+				timeout: time.Minute,
+				next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					_, hasDeadline = req.Context().Deadline()
+					requestErr = req.Context().Err
+					return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+				}),
+			}
+			req := httptest.NewRequest(http.MethodGet, test.url, nil)
+			if test.upgrade != "" {
+				req.Header.Set("Upgrade", test.upgrade)
+			}
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hasDeadline != test.wantDeadline {
+				t.Errorf("got deadline %t, want %t", hasDeadline, test.wantDeadline)
+			}
+			if err := requestErr(); err != nil {
+				t.Errorf("the request was cancelled before the body was read: %v", err)
+			}
+			if err := resp.Body.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if test.wantDeadline && requestErr() == nil {
+				t.Error("closing the body did not release the request context")
+			}
+		})
+	}
+}
+
+func TestTimeoutTransportDeadline(t *testing.T) {
+	transport := &timeoutTransport{
+		timeout: 10 * time.Millisecond,
+		next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}),
+	}
+	_, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, "/api/v1/namespaces", nil))
+	if err == nil || !strings.Contains(err.Error(), "deadline exceeded") {
+		t.Errorf("got error %v, want the deadline to be exceeded", err)
+	}
+}
@@ -0,0 +1,362 @@
+package configmap
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	"github.com/jobayer12/go-kubernetes/module/common"
+	"github.com/jobayer12/go-kubernetes/module/pod"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"net/http"
+)
+
+type ListConfigMapResponse struct {
+	v1.ConfigMapList `json:",inline"`
+}
+
+type GetConfigMapResponse struct {
+	v1.ConfigMap `json:",inline"`
+}
+
+type K8sClient struct {
+	Client kubernetes.Interface
+	Config *rest.Config
+	Cache  *cluster.Cache
+}
+
+type Controller struct {
+	*K8sClient
+}
+
+func NewConfigMapController(k8sClient *K8sClient) Controller {
+	return Controller{K8sClient: k8sClient}
+}
+
+// client returns the clientset of the cluster the request targets.
+func (k *K8sClient) client(ctx *gin.Context) kubernetes.Interface {
+	return cluster.Client(ctx, k.Client)
+}
+
+// cache returns the read cache of the cluster the request targets.
+func (k *K8sClient) cache(ctx *gin.Context) *cluster.Cache {
+	return cluster.CacheFor(ctx, k.Cache)
+}
+
+// ListConfigMap
+// @Summary			Get the List of ConfigMap.
+// @Description		Return list of ConfigMap.
+// @Tags			configmap
+// @Router			/api/v1/namespaces/{namespace}/configmaps [get]
+// @Param 			namespace path string true "Namespace, _all lists every namespace" default(default)
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. metadata.name=settings"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} ListConfigMapResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (cc *Controller) ListConfigMap(ctx *gin.Context) {
+	cc.listConfigMaps(ctx, common.NamespaceParam(ctx))
+}
+
+// ListAllConfigMap
+// @Summary			Get the List of ConfigMap in all namespaces.
+// @Description		Return list of ConfigMap across every namespace.
+// @Tags			configmap
+// @Router			/api/v1/configmaps [get]
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. metadata.name=settings"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} ListConfigMapResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (cc *Controller) ListAllConfigMap(ctx *gin.Context) {
+	cc.listConfigMaps(ctx, metav1.NamespaceAll)
+}
+
+func (cc *Controller) listConfigMaps(ctx *gin.Context, namespace string) {
+	query, err := common.ParseListQuery(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	watch, err := common.WantsWatch(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	configMaps := cc.client(ctx).CoreV1().ConfigMaps(namespace)
+	if watch {
+		common.StreamWatch(ctx, common.ListWatch{
+			List: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				return configMaps.List(ctx, options)
+			},
+			Watch: configMaps.Watch,
+		}, query.WatchOptions())
+		return
+	}
+	list, err := configMaps.List(ctx.Request.Context(), query.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, list)
+}
+
+// GetConfigMap
+// @Summary			Get ConfigMap by name.
+// @Description		Return ConfigMap.
+// @Tags			configmap
+// @Router			/api/v1/namespaces/{namespace}/configmaps/{name} [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "ConfigMap name"
+// @Response		200 {object} GetConfigMapResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (cc *Controller) GetConfigMap(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	configMap, err := cc.client(ctx).CoreV1().ConfigMaps(namespace).Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, configMap)
+}
+
+// CreateConfigMap
+// @Summary			Create ConfigMap
+// @Description		Create a ConfigMap from a JSON or YAML manifest. Use dryRun=All to validate without persisting.
+// @Tags			configmap
+// @Router			/api/v1/namespaces/{namespace}/configmaps [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			request body GetConfigMapResponse true "ConfigMap manifest"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		201 {object} GetConfigMapResponse
+// @Failure			400,401,403,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json,application/yaml
+// @Produce			application/json
+func (cc *Controller) CreateConfigMap(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	configMap, dryRun, err := decodeConfigMap(ctx, namespace, "")
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := cc.client(ctx).CoreV1().ConfigMaps(namespace).Create(ctx.Request.Context(), configMap, metav1.CreateOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, result)
+}
+
+// ReplaceConfigMap
+// @Summary			Replace ConfigMap
+// @Description		Replace a ConfigMap with a JSON or YAML manifest. Set metadata.resourceVersion to guard against concurrent updates.
+// @Tags			configmap
+// @Router			/api/v1/namespaces/{namespace}/configmaps/{name} [put]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "ConfigMap name"
+// @Param 			request body GetConfigMapResponse true "ConfigMap manifest"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} GetConfigMapResponse
+// @Failure			400,401,403,404,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json,application/yaml
+// @Produce			application/json
+func (cc *Controller) ReplaceConfigMap(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	configMap, dryRun, err := decodeConfigMap(ctx, namespace, name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := cc.client(ctx).CoreV1().ConfigMaps(namespace).Update(ctx.Request.Context(), configMap, metav1.UpdateOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// decodeConfigMap decodes the ConfigMap manifest of a create or replace request.
+func decodeConfigMap(ctx *gin.Context, namespace, name string) (*v1.ConfigMap, []string, error) {
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		return nil, nil, apierrors.NewBadRequest(err.Error())
+	}
+	obj, gvk, err := common.DecodeManifest(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	objectMeta, _ := meta.Accessor(obj)
+	if err := common.ValidateManifestMeta(gvk, v1.SchemeGroupVersion.WithKind("ConfigMap"), objectMeta, namespace, name); err != nil {
+		return nil, nil, err
+	}
+	configMap := obj.(*v1.ConfigMap)
+	configMap.Namespace = namespace
+	return configMap, dryRun, nil
+}
+
+// PatchConfigMap
+// @Summary			Patch ConfigMap
+// @Description		Apply a JSON patch, merge patch, strategic merge patch or server-side apply patch, selected by the Content-Type.
+// @Tags			configmap
+// @Router			/api/v1/namespaces/{namespace}/configmaps/{name} [patch]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "ConfigMap name"
+// @Param 			request body object true "Patch document"
+// @Param 			fieldManager query string false "Field manager recorded for the change, required for apply patches" default(go-kubernetes)
+// @Param 			force query bool false "Force an apply patch, taking ownership of conflicting fields"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} GetConfigMapResponse
+// @Failure			400,401,403,404,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json-patch+json,application/merge-patch+json,application/strategic-merge-patch+json,application/apply-patch+yaml
+// @Produce			application/json
+func (cc *Controller) PatchConfigMap(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	patch, err := common.DecodePatch(ctx)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := cc.client(ctx).CoreV1().ConfigMaps(namespace).Patch(ctx.Request.Context(), name, patch.Type, patch.Data, patch.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// DeleteConfigMap
+// @Summary			Delete ConfigMap
+// @Tags			configmap
+// @Router			/api/v1/namespaces/{namespace}/configmaps/{name} [delete]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "ConfigMap name"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @response     	default {boolean}  boolean true
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (cc *Controller) DeleteConfigMap(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	err = cc.client(ctx).CoreV1().ConfigMaps(namespace).Delete(ctx.Request.Context(), name, metav1.DeleteOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, true)
+}
+
+// GetConfigMapKey
+// @Summary			Get ConfigMap key
+// @Description		Return the value of a single key. Keys stored in binaryData are returned base64 encoded.
+// @Tags			configmap
+// @Router			/api/v1/namespaces/{namespace}/configmaps/{name}/keys/{key} [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "ConfigMap name"
+// @Param 			key path string true "Key"
+// @Response		200 {object} KeyResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (cc *Controller) GetConfigMapKey(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	key := ctx.Param("key")
+	configMap, err := cc.client(ctx).CoreV1().ConfigMaps(namespace).Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	value, ok := keyValue(configMap, key)
+	if !ok {
+		common.Error(ctx, apierrors.NewNotFound(v1.Resource("configmaps/keys"), name+"/"+key))
+		return
+	}
+	ctx.JSON(http.StatusOK, value)
+}
+
+// PutConfigMapKey
+// @Summary			Set ConfigMap key
+// @Description		Create or replace a single key, leaving the other keys untouched.
+// @Tags			configmap
+// @Router			/api/v1/namespaces/{namespace}/configmaps/{name}/keys/{key} [put]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "ConfigMap name"
+// @Param 			key path string true "Key"
+// @Param 			request body PutKeyRequest true "Value"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} KeyResponse
+// @Failure			400,401,403,404,409,422,500 {object} common.ErrorResponse
+// @Accept			application/json
+// @Produce			application/json
+func (cc *Controller) PutConfigMapKey(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	key := ctx.Param("key")
+	var request PutKeyRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if err := common.ValidateDataKey(v1.SchemeGroupVersion.WithKind("ConfigMap").GroupKind(), name, key); err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	data, err := putKeyPatch(key, request)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	configMap, err := cc.client(ctx).CoreV1().ConfigMaps(namespace).Patch(ctx.Request.Context(), name, types.MergePatchType, data, metav1.PatchOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	value, _ := keyValue(configMap, key)
+	ctx.JSON(http.StatusOK, value)
+}
+
+// GetConfigMapConsumers
+// @Summary			Get ConfigMap consumers
+// @Description		Return the deployments and pods that reference the ConfigMap through volumes, envFrom or env, i.e. what to restart after changing it.
+// @Tags			configmap
+// @Router			/api/v1/namespaces/{namespace}/configmaps/{name}/consumers [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "ConfigMap name"
+// @Response		200 {object} pod.ConsumersResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (cc *Controller) GetConfigMapConsumers(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	consumers, err := pod.FindConsumers(ctx.Request.Context(), cc.client(ctx), cc.cache(ctx), namespace, "ConfigMap", name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, consumers)
+}
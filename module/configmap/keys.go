@@ -0,0 +1,56 @@
+package configmap
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	EncodingUTF8   = "utf-8"
+	EncodingBase64 = "base64"
+)
+
+type KeyResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	// Encoding is base64 for keys stored in binaryData.
+	Encoding string `json:"encoding"`
+}
+
+type PutKeyRequest struct {
+	Value *string `json:"value" binding:"required"`
+	// Encoding base64 stores the decoded value in binaryData.
+	Encoding string `json:"encoding" enums:"utf-8,base64"`
+}
+
+// keyValue returns the value of key from data or binaryData.
+func keyValue(configMap *v1.ConfigMap, key string) (KeyResponse, bool) {
+	if value, ok := configMap.Data[key]; ok {
+		return KeyResponse{Key: key, Value: value, Encoding: EncodingUTF8}, true
+	}
+	if value, ok := configMap.BinaryData[key]; ok {
+		return KeyResponse{Key: key, Value: base64.StdEncoding.EncodeToString(value), Encoding: EncodingBase64}, true
+	}
+	return KeyResponse{}, false
+}
+
+// putKeyPatch builds the merge patch storing a key in data or binaryData and
+// removing it from the other map, where it may have been stored before.
+func putKeyPatch(key string, request PutKeyRequest) ([]byte, error) {
+	data := map[string]any{key: *request.Value}
+	binaryData := map[string]any{key: nil}
+	switch request.Encoding {
+	case "", EncodingUTF8:
+	case EncodingBase64:
+		if _, err := base64.StdEncoding.DecodeString(*request.Value); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("value is not valid base64: %v", err))
+		}
+		data, binaryData = map[string]any{key: nil}, map[string]any{key: *request.Value}
+	default:
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("encoding must be %s or %s, got %q", EncodingUTF8, EncodingBase64, request.Encoding))
+	}
+	return json.Marshal(map[string]any{"data": data, "binaryData": binaryData})
+}
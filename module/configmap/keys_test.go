@@ -0,0 +1,91 @@
+package configmap
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+)
+
+func TestPutKeyPatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		request PutKeyRequest
+		want    string
+		wantErr string
+	}{
+		{
+			name:    "text value",
+			request: PutKeyRequest{Value: ptr.To("debug")},
+			want:    `{"binaryData":{"level":null},"data":{"level":"debug"}}`,
+		},
+		{
+			name:    "explicit utf-8",
+			request: PutKeyRequest{Value: ptr.To("debug"), Encoding: EncodingUTF8},
+			want:    `{"binaryData":{"level":null},"data":{"level":"debug"}}`,
+		},
+		{
+			name:    "empty value",
+			request: PutKeyRequest{Value: ptr.To("")},
+			want:    `{"binaryData":{"level":null},"data":{"level":""}}`,
+		},
+		{
+			name:    "base64 value",
+			request: PutKeyRequest{Value: ptr.To("AAE="), Encoding: EncodingBase64},
+			want:    `{"binaryData":{"level":"AAE="},"data":{"level":null}}`,
+		},
+		{
+			name:    "invalid base64",
+			request: PutKeyRequest{Value: ptr.To("!!"), Encoding: EncodingBase64},
+			wantErr: "value is not valid base64",
+		},
+		{
+			name:    "unknown encoding",
+			request: PutKeyRequest{Value: ptr.To("debug"), Encoding: "hex"},
+			wantErr: `encoding must be utf-8 or base64, got "hex"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			patch, err := putKeyPatch("level", test.request)
+			if test.wantErr != "" {
+				if !apierrors.IsBadRequest(err) || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want bad request %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(patch) != test.want {
+				t.Errorf("got %s, want %s", patch, test.want)
+			}
+		})
+	}
+}
+
+func TestKeyValue(t *testing.T) {
+	configMap := &v1.ConfigMap{
+		Data:       map[string]string{"level": "debug"},
+		BinaryData: map[string][]byte{"logo": {0x89, 0x50}},
+	}
+	tests := []struct {
+		key    string
+		want   KeyResponse
+		wantOK bool
+	}{
+		{key: "level", want: KeyResponse{Key: "level", Value: "debug", Encoding: EncodingUTF8}, wantOK: true},
+		{key: "logo", want: KeyResponse{Key: "logo", Value: "iVA=", Encoding: EncodingBase64}, wantOK: true},
+		{key: "missing"},
+	}
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			got, ok := keyValue(configMap, test.key)
+			if ok != test.wantOK || got != test.want {
+				t.Errorf("got %+v, %v, want %+v, %v", got, ok, test.want, test.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package configmap
+
+import "github.com/gin-gonic/gin"
+
+type Route struct {
+	controller Controller
+}
+
+func NewConfigMapRoute(controller Controller) Route {
+	return Route{controller}
+}
+
+// AllNamespacesRoute mounts the cross-namespace ConfigMap list, e.g. on /api/v1/configmaps.
+func (r *Route) AllNamespacesRoute(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListAllConfigMap)
+}
+
+func (r *Route) Route(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListConfigMap)
+	router.POST("", r.controller.CreateConfigMap)
+	router.GET(":name", r.controller.GetConfigMap)
+	router.PUT(":name", r.controller.ReplaceConfigMap)
+	router.PATCH(":name", r.controller.PatchConfigMap)
+	router.DELETE(":name", r.controller.DeleteConfigMap)
+	router.GET(":name/keys/:key", r.controller.GetConfigMapKey)
+	router.PUT(":name/keys/:key", r.controller.PutConfigMapKey)
+	router.GET(":name/consumers", r.controller.GetConfigMapConsumers)
+}
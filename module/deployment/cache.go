@@ -0,0 +1,22 @@
+package deployment
+
+import (
+	v1 "k8s.io/api/apps/v1"
+	"sort"
+)
+
+// newDeploymentList builds a list response from cached deployments, ordered by name.
+func newDeploymentList(deployments []*v1.Deployment, resourceVersion string) *v1.DeploymentList {
+	list := &v1.DeploymentList{Items: make([]v1.Deployment, 0, len(deployments))}
+	list.ResourceVersion = resourceVersion
+	for _, deployment := range deployments {
+		list.Items = append(list.Items, *deployment)
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		if list.Items[i].Namespace != list.Items[j].Namespace {
+			return list.Items[i].Namespace < list.Items[j].Namespace
+		}
+		return list.Items[i].Name < list.Items[j].Name
+	})
+	return list
+}
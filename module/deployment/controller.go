@@ -2,13 +2,26 @@ package deployment
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	"github.com/jobayer12/go-kubernetes/module/common"
+	"github.com/jobayer12/go-kubernetes/module/pod"
 	v1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 type ListResponse struct {
@@ -27,8 +40,13 @@ type ScaleDeploymentResponse struct {
 	autoscalingv1.Scale `json:",inline"`
 }
 
+// restartedAtAnnotation is the pod template annotation kubectl rollout restart sets.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
 type K8sClient struct {
 	Client kubernetes.Interface
+	Config *rest.Config
+	Cache  *cluster.Cache
 }
 
 type Controller struct {
@@ -39,19 +57,92 @@ func NewDeploymentController(kubeConfig *K8sClient) Controller {
 	return Controller{K8sClient: kubeConfig}
 }
 
+// client returns the clientset of the cluster the request targets.
+func (k *K8sClient) client(ctx *gin.Context) kubernetes.Interface {
+	return cluster.Client(ctx, k.Client)
+}
+
+// cache returns the read cache of the cluster the request targets.
+func (k *K8sClient) cache(ctx *gin.Context) *cluster.Cache {
+	return cluster.CacheFor(ctx, k.Cache)
+}
+
 // ListDeployment godoc
 // @Summary			Get the List of default namespace deployment.
 // @Description		Return list of deployment.
 // @Tags			deployment
 // @Router			/apis/apps/v1/{namespace}/deployments [get]
-// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			namespace path string true "Namespace, _all lists every namespace" default(default)
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. status.phase=Running"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Param 			consistency query string false "Read from the informer cache (cached) or the apiserver (strong)" Enums(cached, strong) default(cached)
 // @Response		200 {array} ListResponse
-// @Produce			application/json
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
 func (dc *Controller) ListDeployment(ctx *gin.Context) {
-	namespace := ctx.Param("namespace")
-	deployments, err := dc.Client.AppsV1().Deployments(namespace).List(context.TODO(), metav1.ListOptions{})
+	dc.listDeployments(ctx, common.NamespaceParam(ctx))
+}
+
+// ListAllDeployment
+// @Summary			Get the List of deployment in all namespaces.
+// @Description		Return list of deployment across every namespace.
+// @Tags			deployment
+// @Router			/apis/apps/v1/deployments [get]
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. status.phase=Running"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Param 			consistency query string false "Read from the informer cache (cached) or the apiserver (strong)" Enums(cached, strong) default(cached)
+// @Response		200 {array} ListResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (dc *Controller) ListAllDeployment(ctx *gin.Context) {
+	dc.listDeployments(ctx, metav1.NamespaceAll)
+}
+
+func (dc *Controller) listDeployments(ctx *gin.Context, namespace string) {
+	query, err := common.ParseListQuery(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	watch, err := common.WantsWatch(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if watch {
+		deployments := dc.client(ctx).AppsV1().Deployments(namespace)
+		common.StreamWatch(ctx, common.ListWatch{
+			List: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				return deployments.List(ctx, options)
+			},
+			Watch: deployments.Watch,
+		}, query.WatchOptions())
+		return
+	}
+	cached, err := cluster.UseCache(ctx, dc.cache(ctx), query.Cacheable())
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if cached {
+		items, err := dc.cache(ctx).Deployments().Deployments(namespace).List(query.Labels)
+		if err != nil {
+			common.Error(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, newDeploymentList(items, dc.cache(ctx).DeploymentsResourceVersion()))
+		return
+	}
+	deployments, err := dc.client(ctx).AppsV1().Deployments(namespace).List(ctx.Request.Context(), query.Options)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, err)
+		common.Error(ctx, err)
+		return
 	}
 	ctx.JSON(http.StatusOK, deployments)
 }
@@ -63,19 +154,67 @@ func (dc *Controller) ListDeployment(ctx *gin.Context) {
 // @Router			/apis/apps/v1/{namespace}/deployments/{name} [get]
 // @Param 			namespace path string true "Namespace" default(default)
 // @Param 			name path string true "Deployment name"
+// @Param 			consistency query string false "Read from the informer cache (cached) or the apiserver (strong)" Enums(cached, strong) default(cached)
 // @Response		200 {object} GetDeploymentResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
 // @Produce			application/json
 func (dc *Controller) GetDeployment(ctx *gin.Context) {
 	namespace := ctx.Param("namespace")
 	name := ctx.Param("name")
-	result, err := dc.Client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	cached, err := cluster.UseCache(ctx, dc.cache(ctx), true)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, err)
+		common.BadRequest(ctx, err)
+		return
+	}
+	if cached {
+		deployment, err := dc.cache(ctx).Deployments().Deployments(namespace).Get(name)
+		if err != nil {
+			common.Error(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, deployment)
+		return
+	}
+	result, err := dc.getDeployment(ctx, namespace, name)
+	if err != nil {
+		common.Error(ctx, err)
 		return
 	}
 	ctx.JSON(http.StatusOK, result)
 }
 
+// getDeployment looks up a deployment in the cluster the request targets.
+func (dc *Controller) getDeployment(ctx *gin.Context, namespace, name string) (*v1.Deployment, error) {
+	return dc.client(ctx).AppsV1().Deployments(namespace).Get(ctx.Request.Context(), name, metav1.GetOptions{})
+}
+
+// patchDeployment applies a strategic merge patch to a deployment.
+func (dc *Controller) patchDeployment(ctx *gin.Context, namespace, name string, patch any) (*v1.Deployment, error) {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	return dc.client(ctx).AppsV1().Deployments(namespace).Patch(ctx.Request.Context(), name, types.StrategicMergePatchType, data, metav1.PatchOptions{})
+}
+
+// respondWithPatch patches a deployment and writes the updated object.
+func (dc *Controller) respondWithPatch(ctx *gin.Context, namespace, name string, patch any) {
+	result, err := dc.patchDeployment(ctx, namespace, name, patch)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+func pausedPatch(paused bool) map[string]any {
+	return map[string]any{
+		"spec": map[string]any{
+			"paused": paused,
+		},
+	}
+}
+
 // DeleteDeployment
 // @Summary			Delete deployment
 // @Tags			deployment
@@ -83,13 +222,14 @@ func (dc *Controller) GetDeployment(ctx *gin.Context) {
 // @Param 			namespace path string true "Namespace" default(default)
 // @Param 			name path string true "Deployment name"
 // @response     	default {boolean}  boolean true
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
 // @Produce			application/json
 func (dc *Controller) DeleteDeployment(ctx *gin.Context) {
 	namespace := ctx.Param("namespace")
 	name := ctx.Param("name")
-	err := dc.Client.AppsV1().Deployments(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	err := dc.client(ctx).AppsV1().Deployments(namespace).Delete(ctx.Request.Context(), name, metav1.DeleteOptions{})
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, err)
+		common.Error(ctx, err)
 		return
 	}
 	ctx.JSON(http.StatusOK, true)
@@ -102,13 +242,14 @@ func (dc *Controller) DeleteDeployment(ctx *gin.Context) {
 // @Param 			namespace path string true "Namespace" default(default)
 // @Param 			name path string true "Deployment name"
 // @Response		200 {object} ScaleDeploymentResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
 // @Produce			application/json
 func (dc *Controller) ReadDeploymentScale(ctx *gin.Context) {
 	namespace := ctx.Param("namespace")
 	name := ctx.Param("name")
-	scaleObj, err := dc.Client.AppsV1().Deployments(namespace).GetScale(context.TODO(), name, metav1.GetOptions{})
+	scaleObj, err := dc.client(ctx).AppsV1().Deployments(namespace).GetScale(ctx.Request.Context(), name, metav1.GetOptions{})
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, err)
+		common.Error(ctx, err)
 		return
 	}
 	ctx.JSON(http.StatusOK, scaleObj)
@@ -122,31 +263,641 @@ func (dc *Controller) ReadDeploymentScale(ctx *gin.Context) {
 // @Param 			name path string true "Deployment name"
 // @Param 			replica path string true "Replica"
 // @Response		200 {object} ScaleDeploymentResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
 // @Produce			application/json
 func (dc *Controller) UpdateDeploymentReplica(ctx *gin.Context) {
 	namespace := ctx.Param("namespace")
 	name := ctx.Param("name")
 	replicaParam, err := strconv.ParseInt(ctx.Param("replica"), 10, 32)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, err)
+		common.BadRequest(ctx, err)
 		return
 	}
-	scaleObj, err := dc.Client.AppsV1().Deployments(namespace).GetScale(context.Background(), name, metav1.GetOptions{})
+	scaleObj, err := dc.client(ctx).AppsV1().Deployments(namespace).GetScale(ctx.Request.Context(), name, metav1.GetOptions{})
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, err)
+		common.Error(ctx, err)
 		return
 	}
 	replica := int32(replicaParam)
 	sd := *scaleObj
 	if sd.Spec.Replicas == replica || replica < 0 {
-		ctx.JSON(http.StatusBadRequest, "No changes applied")
+		common.BadRequest(ctx, errors.New("no changes applied"))
 		return
 	}
 	sd.Spec.Replicas = replica
-	scaleDeployment, err := dc.Client.AppsV1().Deployments(namespace).UpdateScale(context.Background(), name, &sd, metav1.UpdateOptions{})
+	scaleDeployment, err := dc.client(ctx).AppsV1().Deployments(namespace).UpdateScale(ctx.Request.Context(), name, &sd, metav1.UpdateOptions{})
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, err)
+		common.Error(ctx, err)
 		return
 	}
 	ctx.JSON(http.StatusOK, scaleDeployment)
 }
+
+// GetDeploymentLogs
+// @Summary			Get the logs of every Pod of a deployment.
+// @Description		Return the logs of all Pods selected by the deployment, every line prefixed with pod and container name and ordered by timestamp. With follow=true the logs are streamed as they arrive until the client disconnects. Without follow at most 8MiB of log messages are returned; when the logs were cut off the X-Logs-Truncated header is set and a truncated event is sent before the end event.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/logs [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Param 			container query string false "Only return the logs of this container"
+// @Param 			follow query bool false "Stream the logs until the client disconnects"
+// @Param 			previous query bool false "Return the logs of the previous terminated containers"
+// @Param 			timestamps query bool false "Include the timestamp of every line"
+// @Param 			tailLines query int false "Number of lines from the end of each container log to show, 100 unless tailLines or limitBytes is set"
+// @Param 			sinceSeconds query int false "Only return logs newer than this many seconds"
+// @Param 			sinceTime query string false "Only return logs after this RFC3339 timestamp"
+// @Param 			limitBytes query int false "Maximum number of bytes to return per container"
+// @Param 			concurrency query int false "Number of container logs fetched in parallel" default(5)
+// @Param 			format query string false "Set to sse to receive Server-Sent Events" Enums(sse)
+// @Response		200 {string} string
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			text/plain,text/event-stream
+func (dc *Controller) GetDeploymentLogs(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	query, err := pod.BindLogQuery(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	options, err := query.PodLogOptions()
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if options.TailLines == nil && options.LimitBytes == nil {
+		tailLines := int64(defaultLogTailLines)
+		options.TailLines = &tailLines
+	}
+	concurrency, err := strconv.Atoi(ctx.DefaultQuery("concurrency", strconv.Itoa(defaultLogConcurrency)))
+	if err != nil || concurrency < 1 || concurrency > maxLogConcurrency {
+		common.BadRequest(ctx, fmt.Errorf("concurrency must be between 1 and %d", maxLogConcurrency))
+		return
+	}
+	deployment, err := dc.getDeployment(ctx, namespace, name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	targets, err := dc.logTargets(ctx, deployment, query.Container)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	if options.Follow && len(targets) > maxFollowStreams {
+		common.BadRequest(ctx, fmt.Errorf("following %d containers exceeds the limit of %d, select a container", len(targets), maxFollowStreams))
+		return
+	}
+
+	logs := &logAggregator{
+		client:      dc.client(ctx),
+		namespace:   namespace,
+		options:     *options,
+		timestamps:  query.Timestamps,
+		concurrency: concurrency,
+		maxBytes:    maxLogBytes,
+	}
+	var (
+		lines     []LogLine
+		truncated bool
+	)
+	if !options.Follow {
+		lines, truncated = logs.collect(ctx.Request.Context(), targets)
+		if truncated {
+			ctx.Header(logsTruncatedHeader, "true")
+		}
+	}
+	sse := common.WantsEventStream(ctx)
+	if sse {
+		common.StartEventStream(ctx)
+	} else {
+		ctx.Header("Content-Type", "text/plain; charset=utf-8")
+		ctx.Status(http.StatusOK)
+	}
+	write := func(line LogLine) {
+		if sse {
+			common.SendEvent(ctx, "log", line)
+			return
+		}
+		_, _ = ctx.Writer.WriteString(line.String() + "\n")
+		if options.Follow {
+			ctx.Writer.Flush()
+		}
+	}
+	if options.Follow {
+		logs.follow(ctx.Request.Context(), targets, write)
+	} else {
+		for _, line := range lines {
+			write(line)
+		}
+	}
+	if sse {
+		if truncated {
+			common.SendEvent(ctx, "truncated", fmt.Sprintf("the logs exceed %d bytes", maxLogBytes))
+		}
+		common.SendEvent(ctx, "end", "")
+	}
+}
+
+// RestartDeployment
+// @Summary			Restart deployment
+// @Description		Trigger a rolling restart by stamping the pod template with a restartedAt annotation, like kubectl rollout restart.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/restart [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Response		200 {object} GetDeploymentResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (dc *Controller) RestartDeployment(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	deployment, err := dc.getDeployment(ctx, namespace, name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	if deployment.Spec.Paused {
+		common.BadRequest(ctx, fmt.Errorf("deployment %q is paused, resume it before restarting", name))
+		return
+	}
+	patch := map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]string{
+						restartedAtAnnotation: time.Now().Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	}
+	dc.respondWithPatch(ctx, namespace, name, patch)
+}
+
+// PauseDeployment
+// @Summary			Pause deployment rollout
+// @Description		Set spec.paused so that changes to the pod template do not trigger a rollout.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/pause [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Response		200 {object} GetDeploymentResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (dc *Controller) PauseDeployment(ctx *gin.Context) {
+	dc.respondWithPatch(ctx, ctx.Param("namespace"), ctx.Param("name"), pausedPatch(true))
+}
+
+// ResumeDeployment
+// @Summary			Resume deployment rollout
+// @Description		Clear spec.paused so that pending changes are rolled out.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/resume [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Response		200 {object} GetDeploymentResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (dc *Controller) ResumeDeployment(ctx *gin.Context) {
+	dc.respondWithPatch(ctx, ctx.Param("namespace"), ctx.Param("name"), pausedPatch(false))
+}
+
+// GetDeploymentHistory
+// @Summary			Get deployment rollout history
+// @Description		Return the revisions of a deployment from its ReplicaSets, with change-cause, images and creation time.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/history [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Response		200 {object} HistoryResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (dc *Controller) GetDeploymentHistory(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	deployment, err := dc.getDeployment(ctx, namespace, name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	replicaSets, err := dc.ownedReplicaSets(ctx, deployment)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, newHistory(deployment, replicaSets))
+}
+
+// RollbackDeployment
+// @Summary			Roll back deployment
+// @Description		Copy the pod template of a previous revision back onto the deployment. Without a revision the previous one is used.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/rollback [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Param 			request body RollbackRequest false "Target revision"
+// @Response		200 {object} GetDeploymentResponse
+// @Failure			400,401,403,404,409,500 {object} common.ErrorResponse
+// @Accept			application/json
+// @Produce			application/json
+func (dc *Controller) RollbackDeployment(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	var request RollbackRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			common.BadRequest(ctx, err)
+			return
+		}
+	}
+	if request.Revision < 0 {
+		common.BadRequest(ctx, errors.New("revision must not be negative"))
+		return
+	}
+	deployment, err := dc.getDeployment(ctx, namespace, name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	if deployment.Spec.Paused {
+		common.BadRequest(ctx, fmt.Errorf("deployment %q is paused, resume it before rolling back", name))
+		return
+	}
+	replicaSets, err := dc.ownedReplicaSets(ctx, deployment)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	target, err := rollbackTarget(deployment, replicaSets, request.Revision)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	if revisionOf(&target.ObjectMeta) == revisionOf(&deployment.ObjectMeta) {
+		ctx.JSON(http.StatusOK, deployment)
+		return
+	}
+	data, err := json.Marshal(rollbackPatch(deployment, target))
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := dc.client(ctx).AppsV1().Deployments(namespace).Patch(ctx.Request.Context(), name, types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// GetDeploymentRolloutStatus
+// @Summary			Get deployment rollout status
+// @Description		Return whether the rollout is progressing, complete, failed (ProgressDeadlineExceeded) or paused. With wait=true the request blocks until a terminal state or the timeout; with format=sse every intermediate state is streamed.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/status [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Param 			wait query bool false "Block until the rollout is complete, failed or paused"
+// @Param 			timeout query string false "Maximum time to wait, e.g. 90s" default(5m)
+// @Param 			format query string false "Set to sse to receive Server-Sent Events" Enums(sse)
+// @Response		200 {object} RolloutStatus
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (dc *Controller) GetDeploymentRolloutStatus(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	wait, err := strconv.ParseBool(ctx.DefaultQuery("wait", "false"))
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	timeout, err := parseRolloutTimeout(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	sse := common.WantsEventStream(ctx)
+	if !wait && !sse {
+		deployment, err := dc.getDeployment(ctx, namespace, name)
+		if err != nil {
+			common.Error(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, newRolloutStatus(deployment))
+		return
+	}
+	if _, err := dc.getDeployment(ctx, namespace, name); err != nil {
+		common.Error(ctx, err)
+		return
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx.Request.Context(), timeout)
+	defer cancel()
+	onUpdate := func(RolloutStatus) {}
+	if sse {
+		common.StartEventStream(ctx)
+		onUpdate = func(status RolloutStatus) {
+			common.SendEvent(ctx, "status", status)
+		}
+	}
+	status, err := dc.waitForRollout(ctx, runCtx, namespace, name, onUpdate)
+	switch {
+	case sse && err != nil:
+		common.StreamError(ctx, err)
+	case sse:
+		common.SendEvent(ctx, "end", status)
+	case err != nil:
+		common.Error(ctx, err)
+	default:
+		ctx.JSON(http.StatusOK, status)
+	}
+}
+
+// SetDeploymentImage
+// @Summary			Update container images of a deployment
+// @Description		Set the image of one or more containers (including init containers) and record the change-cause. With wait=true the response is sent once the rollout finished or the timeout expired.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/images [patch]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Param 			request body SetImageRequest true "Container name to image map"
+// @Param 			wait query bool false "Wait for the rollout to finish"
+// @Param 			timeout query string false "Maximum time to wait, e.g. 90s" default(5m)
+// @Response		200 {object} SetImageResponse
+// @Failure			400,401,403,404,409,422,500 {object} common.ErrorResponse
+// @Accept			application/json
+// @Produce			application/json
+func (dc *Controller) SetDeploymentImage(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	var request SetImageRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	wait, err := strconv.ParseBool(ctx.DefaultQuery("wait", "false"))
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	timeout, err := parseRolloutTimeout(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	deployment, err := dc.getDeployment(ctx, namespace, name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	patch, err := setImagePatch(deployment, request)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := dc.patchDeployment(ctx, namespace, name, patch)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	response := SetImageResponse{Deployment: result}
+	if wait {
+		runCtx, cancel := context.WithTimeout(ctx.Request.Context(), timeout)
+		defer cancel()
+		status, err := dc.waitForRollout(ctx, runCtx, namespace, name, func(RolloutStatus) {})
+		if err != nil {
+			common.Error(ctx, err)
+			return
+		}
+		response.Rollout = &status
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// CreateDeployment
+// @Summary			Create deployment
+// @Description		Create a deployment from a JSON or YAML manifest. Use dryRun=All to validate without persisting.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			request body GetDeploymentResponse true "Deployment manifest"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		201 {object} GetDeploymentResponse
+// @Failure			400,401,403,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json,application/yaml
+// @Produce			application/json
+func (dc *Controller) CreateDeployment(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	deployment, dryRun, err := decodeDeployment(ctx, namespace, "")
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := dc.client(ctx).AppsV1().Deployments(namespace).Create(ctx.Request.Context(), deployment, metav1.CreateOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, result)
+}
+
+// ReplaceDeployment
+// @Summary			Replace deployment
+// @Description		Replace a deployment with a JSON or YAML manifest. Set metadata.resourceVersion to guard against concurrent updates.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name} [put]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Param 			request body GetDeploymentResponse true "Deployment manifest"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} GetDeploymentResponse
+// @Failure			400,401,403,404,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json,application/yaml
+// @Produce			application/json
+func (dc *Controller) ReplaceDeployment(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	deployment, dryRun, err := decodeDeployment(ctx, namespace, name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := dc.client(ctx).AppsV1().Deployments(namespace).Update(ctx.Request.Context(), deployment, metav1.UpdateOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// decodeDeployment decodes the deployment manifest of a create or replace request.
+func decodeDeployment(ctx *gin.Context, namespace, name string) (*v1.Deployment, []string, error) {
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		return nil, nil, apierrors.NewBadRequest(err.Error())
+	}
+	obj, gvk, err := common.DecodeManifest(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	objectMeta, _ := meta.Accessor(obj)
+	if err := common.ValidateManifestMeta(gvk, v1.SchemeGroupVersion.WithKind("Deployment"), objectMeta, namespace, name); err != nil {
+		return nil, nil, err
+	}
+	deployment := obj.(*v1.Deployment)
+	deployment.Namespace = namespace
+	return deployment, dryRun, nil
+}
+
+// PatchDeployment
+// @Summary			Patch deployment
+// @Description		Apply a JSON patch, merge patch, strategic merge patch or server-side apply patch, selected by the Content-Type.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name} [patch]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Param 			request body object true "Patch document"
+// @Param 			fieldManager query string false "Field manager recorded for the change, required for apply patches" default(go-kubernetes)
+// @Param 			force query bool false "Force an apply patch, taking ownership of conflicting fields"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} GetDeploymentResponse
+// @Failure			400,401,403,404,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json-patch+json,application/merge-patch+json,application/strategic-merge-patch+json,application/apply-patch+yaml
+// @Produce			application/json
+func (dc *Controller) PatchDeployment(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	patch, err := common.DecodePatch(ctx)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := dc.client(ctx).AppsV1().Deployments(namespace).Patch(ctx.Request.Context(), name, patch.Type, patch.Data, patch.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// ReplaceDeploymentScale
+// @Summary			Replace deployment scale
+// @Description		Update the scale subresource. Set metadata.resourceVersion for optimistic concurrency; a conflicting update returns 409. Setting the current replica count succeeds without changes.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/scale [put]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Param 			request body ScaleDeploymentResponse true "Scale"
+// @Param 			wait query bool false "Wait until status.readyReplicas matches the desired replicas"
+// @Param 			timeout query string false "Maximum time to wait, e.g. 90s" default(5m)
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} ScaleDeploymentResponse
+// @Failure			400,401,403,404,409,422,500,504 {object} common.ErrorResponse
+// @Accept			application/json
+// @Produce			application/json
+func (dc *Controller) ReplaceDeploymentScale(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	var scale autoscalingv1.Scale
+	if err := ctx.ShouldBindJSON(&scale); err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if err := validateScale(&scale, name); err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	shouldWait, timeout, err := scaleWait(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	scale.Name = name
+	scale.Namespace = namespace
+	result, err := dc.client(ctx).AppsV1().Deployments(namespace).UpdateScale(ctx.Request.Context(), name, &scale, metav1.UpdateOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	dc.respondWithScale(ctx, namespace, name, result, shouldWait && dryRun == nil, timeout)
+}
+
+// PatchDeploymentScale
+// @Summary			Patch deployment scale
+// @Description		Patch the scale subresource, e.g. {"spec":{"replicas":3}} as a merge patch. Include metadata.resourceVersion to fail with 409 on concurrent changes.
+// @Tags			deployment
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/scale [patch]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Param 			request body object true "Patch document"
+// @Param 			wait query bool false "Wait until status.readyReplicas matches the desired replicas"
+// @Param 			timeout query string false "Maximum time to wait, e.g. 90s" default(5m)
+// @Param 			fieldManager query string false "Field manager recorded for the change, required for apply patches" default(go-kubernetes)
+// @Param 			force query bool false "Force an apply patch, taking ownership of conflicting fields"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} ScaleDeploymentResponse
+// @Failure			400,401,403,404,409,415,422,500,504 {object} common.ErrorResponse
+// @Accept			application/merge-patch+json,application/json-patch+json,application/strategic-merge-patch+json,application/apply-patch+yaml
+// @Produce			application/json
+func (dc *Controller) PatchDeploymentScale(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	patch, err := common.DecodePatch(ctx)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	shouldWait, timeout, err := scaleWait(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	result := &autoscalingv1.Scale{}
+	err = dc.client(ctx).AppsV1().RESTClient().Patch(patch.Type).
+		Namespace(namespace).
+		Resource("deployments").
+		Name(name).
+		SubResource("scale").
+		VersionedParams(&patch.Options, scheme.ParameterCodec).
+		Body(patch.Data).
+		Do(ctx.Request.Context()).
+		Into(result)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	dc.respondWithScale(ctx, namespace, name, result, shouldWait && patch.Options.DryRun == nil, timeout)
+}
+
+// scaleWait reads the wait and timeout query parameters of the scale endpoints.
+func scaleWait(ctx *gin.Context) (bool, time.Duration, error) {
+	shouldWait, err := strconv.ParseBool(ctx.DefaultQuery("wait", "false"))
+	if err != nil {
+		return false, 0, err
+	}
+	timeout, err := parseRolloutTimeout(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	return shouldWait, timeout, nil
+}
+
+// respondWithScale writes scale, first waiting for the ready replicas when shouldWait is set.
+func (dc *Controller) respondWithScale(ctx *gin.Context, namespace, name string, scale *autoscalingv1.Scale, shouldWait bool, timeout time.Duration) {
+	if shouldWait {
+		var err error
+		scale, err = dc.waitForReadyReplicas(ctx, namespace, name, timeout)
+		if err != nil {
+			common.Error(ctx, err)
+			return
+		}
+	}
+	ctx.JSON(http.StatusOK, scale)
+}
@@ -0,0 +1,69 @@
+package deployment
+
+import (
+	"strconv"
+
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+const testDeploymentUID = types.UID("deployment-uid")
+
+func newTestDeployment(revision int64, image string) *v1.Deployment {
+	return &v1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "web",
+			Namespace:       "default",
+			UID:             testDeploymentUID,
+			ResourceVersion: "7",
+			Annotations:     map[string]string{revisionAnnotation: strconv.FormatInt(revision, 10)},
+		},
+		Spec: v1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: image}}},
+			},
+		},
+	}
+}
+
+// newTestImageDeployment is a deployment with a sidecar and an init container.
+func newTestImageDeployment() *v1.Deployment {
+	deployment := newTestDeployment(1, "nginx:1.24")
+	deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{Name: "sidecar", Image: "envoy:1.27"})
+	deployment.Spec.Template.Spec.InitContainers = []corev1.Container{{Name: "migrate", Image: "migrate:1"}}
+	return deployment
+}
+
+func newTestReplicaSet(revision int64, image, changeCause string) v1.ReplicaSet {
+	annotations := map[string]string{revisionAnnotation: strconv.FormatInt(revision, 10)}
+	if changeCause != "" {
+		annotations[changeCauseAnnotation] = changeCause
+	}
+	hash := "hash-" + strconv.FormatInt(revision, 10)
+	return v1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "web-" + hash,
+			Namespace:   "default",
+			Labels:      map[string]string{"app": "web", v1.DefaultDeploymentUniqueLabelKey: hash},
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "web",
+				UID:        testDeploymentUID,
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec: v1.ReplicaSetSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web", v1.DefaultDeploymentUniqueLabelKey: hash}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: image}}},
+			},
+		},
+	}
+}
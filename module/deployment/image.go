@@ -0,0 +1,90 @@
+package deployment
+
+import (
+	"fmt"
+	v1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sort"
+	"strings"
+)
+
+type SetImageRequest struct {
+	// Images maps container (or init container) names to their new image.
+	Images map[string]string `json:"images" binding:"required,min=1"`
+	// ChangeCause is recorded in the kubernetes.io/change-cause annotation.
+	ChangeCause string `json:"changeCause"`
+}
+
+type SetImageResponse struct {
+	Deployment *v1.Deployment `json:"deployment"`
+	Rollout    *RolloutStatus `json:"rollout,omitempty"`
+}
+
+// setImagePatch validates that every container in images exists and builds the
+// strategic merge patch updating their images.
+func setImagePatch(deployment *v1.Deployment, request SetImageRequest) (map[string]any, error) {
+	spec := deployment.Spec.Template.Spec
+	containers := map[string]bool{}
+	for _, c := range spec.Containers {
+		containers[c.Name] = false
+	}
+	for _, c := range spec.InitContainers {
+		containers[c.Name] = true
+	}
+
+	names := make([]string, 0, len(request.Images))
+	for name := range request.Images {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs field.ErrorList
+	var patchContainers, patchInitContainers []map[string]string
+	for _, name := range names {
+		image := strings.TrimSpace(request.Images[name])
+		path := field.NewPath("images").Key(name)
+		init, ok := containers[name]
+		switch {
+		case !ok:
+			errs = append(errs, field.NotFound(path, name))
+		case image == "":
+			errs = append(errs, field.Required(path, "image must not be empty"))
+		case init:
+			patchInitContainers = append(patchInitContainers, map[string]string{"name": name, "image": image})
+		default:
+			patchContainers = append(patchContainers, map[string]string{"name": name, "image": image})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, apierrors.NewInvalid(v1.SchemeGroupVersion.WithKind("Deployment").GroupKind(), deployment.Name, errs)
+	}
+
+	podSpec := map[string]any{}
+	if len(patchContainers) > 0 {
+		podSpec["containers"] = patchContainers
+	}
+	if len(patchInitContainers) > 0 {
+		podSpec["initContainers"] = patchInitContainers
+	}
+	changeCause := request.ChangeCause
+	if changeCause == "" {
+		pairs := make([]string, 0, len(names))
+		for _, name := range names {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", name, strings.TrimSpace(request.Images[name])))
+		}
+		changeCause = "set image " + strings.Join(pairs, ",")
+	}
+	return map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				changeCauseAnnotation: changeCause,
+			},
+		},
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": podSpec,
+			},
+		},
+	}, nil
+}
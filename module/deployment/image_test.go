@@ -0,0 +1,113 @@
+package deployment
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSetImagePatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     SetImageRequest
+		want        string
+		wantInvalid []string
+	}{
+		{
+			name:    "container",
+			request: SetImageRequest{Images: map[string]string{"app": "nginx:1.25"}},
+			want:    `{"metadata":{"annotations":{"kubernetes.io/change-cause":"set image app=nginx:1.25"}},"spec":{"template":{"spec":{"containers":[{"image":"nginx:1.25","name":"app"}]}}}}`,
+		},
+		{
+			name:    "containers and init containers sorted by name",
+			request: SetImageRequest{Images: map[string]string{"sidecar": "envoy:1.28", "migrate": "migrate:2", "app": "nginx:1.25"}},
+			want:    `{"metadata":{"annotations":{"kubernetes.io/change-cause":"set image app=nginx:1.25,migrate=migrate:2,sidecar=envoy:1.28"}},"spec":{"template":{"spec":{"containers":[{"image":"nginx:1.25","name":"app"},{"image":"envoy:1.28","name":"sidecar"}],"initContainers":[{"image":"migrate:2","name":"migrate"}]}}}}`,
+		},
+		{
+			name:    "explicit change cause and trimmed image",
+			request: SetImageRequest{Images: map[string]string{"app": " nginx:1.25 "}, ChangeCause: "CVE fix"},
+			want:    `{"metadata":{"annotations":{"kubernetes.io/change-cause":"CVE fix"}},"spec":{"template":{"spec":{"containers":[{"image":"nginx:1.25","name":"app"}]}}}}`,
+		},
+		{
+			name:    "change cause uses the trimmed image",
+			request: SetImageRequest{Images: map[string]string{"app": "nginx:1.25\n"}},
+			want:    `{"metadata":{"annotations":{"kubernetes.io/change-cause":"set image app=nginx:1.25"}},"spec":{"template":{"spec":{"containers":[{"image":"nginx:1.25","name":"app"}]}}}}`,
+		},
+		{
+			name:        "unknown container",
+			request:     SetImageRequest{Images: map[string]string{"app": "nginx:1.25", "db": "postgres:16"}},
+			wantInvalid: []string{"images[db]"},
+		},
+		{
+			name:        "empty image",
+			request:     SetImageRequest{Images: map[string]string{"app": " ", "sidecar": ""}},
+			wantInvalid: []string{"images[app]", "images[sidecar]"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			patch, err := setImagePatch(newTestImageDeployment(), test.request)
+			if test.wantInvalid != nil {
+				if !apierrors.IsInvalid(err) {
+					t.Fatalf("got error %v, want Invalid", err)
+				}
+				causes := err.(apierrors.APIStatus).Status().Details.Causes
+				if len(causes) != len(test.wantInvalid) {
+					t.Fatalf("got causes %+v, want fields %v", causes, test.wantInvalid)
+				}
+				for i, cause := range causes {
+					if cause.Field != test.wantInvalid[i] {
+						t.Errorf("got field %q, want %q", cause.Field, test.wantInvalid[i])
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			data, err := json.Marshal(patch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != test.want {
+				t.Errorf("got patch\n%s\nwant\n%s", data, test.want)
+			}
+		})
+	}
+}
+
+func TestSetDeploymentImage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	client := fake.NewSimpleClientset(newTestImageDeployment())
+	route := NewDeploymentRoute(NewDeploymentController(&K8sClient{Client: client}))
+	router := gin.New()
+	route.DeploymentRoute(router.Group("/namespaces/:namespace/deployments"))
+
+	request := httptest.NewRequest(http.MethodPatch, "/namespaces/default/deployments/web/images", strings.NewReader(`{"images":{"app":"nginx:1.25"}}`))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("got status %d: %s", recorder.Code, recorder.Body.String())
+	}
+	var response SetImageResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	images := map[string]string{}
+	for _, c := range response.Deployment.Spec.Template.Spec.Containers {
+		images[c.Name] = c.Image
+	}
+	if images["app"] != "nginx:1.25" || images["sidecar"] != "envoy:1.27" {
+		t.Errorf("got images %v, want app updated and sidecar untouched", images)
+	}
+	if cause := response.Deployment.Annotations[changeCauseAnnotation]; cause != "set image app=nginx:1.25" {
+		t.Errorf("got change cause %q", cause)
+	}
+}
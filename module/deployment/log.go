@@ -0,0 +1,199 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/pod"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultLogConcurrency = 5
+	maxLogConcurrency     = 20
+	// maxFollowStreams bounds the number of log streams kept open by a single follow request.
+	maxFollowStreams = 50
+	// defaultLogTailLines is applied per container when neither tailLines nor limitBytes is set.
+	defaultLogTailLines = 100
+	// maxLogBytes bounds the log messages collected by a single request across all containers.
+	maxLogBytes = 8 << 20
+	// logsTruncatedHeader is set when the collected logs were cut off at maxLogBytes.
+	logsTruncatedHeader = "X-Logs-Truncated"
+)
+
+// LogLine is a single log line of a container belonging to a deployment.
+type LogLine struct {
+	Pod       string    `json:"pod"`
+	Container string    `json:"container"`
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+
+	showTimestamp bool
+}
+
+func (l LogLine) String() string {
+	if l.showTimestamp && !l.Timestamp.IsZero() {
+		return fmt.Sprintf("[%s/%s] %s %s", l.Pod, l.Container, l.Timestamp.Format(time.RFC3339Nano), l.Message)
+	}
+	return fmt.Sprintf("[%s/%s] %s", l.Pod, l.Container, l.Message)
+}
+
+type logTarget struct {
+	pod       string
+	container string
+}
+
+// logTargets lists the containers of every pod selected by the deployment,
+// optionally restricted to a single container name.
+func (dc *Controller) logTargets(ctx *gin.Context, deployment *v1.Deployment, container string) ([]logTarget, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := dc.client(ctx).CoreV1().Pods(deployment.Namespace).List(ctx.Request.Context(), metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var targets []logTarget
+	for _, p := range pods.Items {
+		if p.Status.Phase == corev1.PodPending {
+			continue
+		}
+		for _, c := range p.Spec.Containers {
+			if container != "" && c.Name != container {
+				continue
+			}
+			targets = append(targets, logTarget{pod: p.Name, container: c.Name})
+		}
+	}
+	return targets, nil
+}
+
+type logAggregator struct {
+	client      kubernetes.Interface
+	namespace   string
+	options     corev1.PodLogOptions
+	timestamps  bool
+	concurrency int
+	// maxBytes bounds the size of the messages returned by collect.
+	maxBytes int
+}
+
+// collect fetches the logs of every target with bounded parallelism and
+// returns the lines ordered by timestamp. It stops reading once the messages
+// exceed maxBytes and reports whether the logs were truncated.
+func (a *logAggregator) collect(ctx context.Context, targets []logTarget) ([]LogLine, bool) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu        sync.Mutex
+		lines     []LogLine
+		size      int
+		truncated bool
+		wg        sync.WaitGroup
+	)
+	semaphore := make(chan struct{}, a.concurrency)
+	for _, target := range targets {
+		semaphore <- struct{}{}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(target logTarget) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			a.read(ctx, target, func(line LogLine) {
+				mu.Lock()
+				defer mu.Unlock()
+				if truncated {
+					return
+				}
+				size += len(line.Message)
+				if size > a.maxBytes {
+					truncated = true
+					cancel()
+					return
+				}
+				lines = append(lines, line)
+			})
+		}(target)
+	}
+	wg.Wait()
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].Timestamp.Before(lines[j].Timestamp)
+	})
+	return lines, truncated
+}
+
+// follow streams the logs of every target concurrently and passes each line to
+// write as it arrives, until all streams end or ctx is cancelled.
+func (a *logAggregator) follow(ctx context.Context, targets []logTarget, write func(LogLine)) {
+	lines := make(chan LogLine)
+	var wg sync.WaitGroup
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target logTarget) {
+			defer wg.Done()
+			a.read(ctx, target, func(line LogLine) {
+				select {
+				case lines <- line:
+				case <-ctx.Done():
+				}
+			})
+		}(target)
+	}
+	go func() {
+		wg.Wait()
+		close(lines)
+	}()
+	for line := range lines {
+		write(line)
+	}
+}
+
+// read streams the log of a single container. Failures are reported as a log
+// line so that one broken container does not hide the others.
+func (a *logAggregator) read(ctx context.Context, target logTarget, emit func(LogLine)) {
+	options := a.options
+	options.Container = target.container
+	options.Timestamps = true
+	stream, err := a.client.CoreV1().Pods(a.namespace).GetLogs(target.pod, &options).Stream(ctx)
+	if err != nil {
+		emit(LogLine{
+			Pod:       target.pod,
+			Container: target.container,
+			Timestamp: time.Now(),
+			Message:   fmt.Sprintf("error: %v", err),
+		})
+		return
+	}
+	defer stream.Close()
+	scanner := pod.NewLogScanner(stream)
+	for scanner.Scan() {
+		line := LogLine{Pod: target.pod, Container: target.container, showTimestamp: a.timestamps}
+		line.Timestamp, line.Message = splitTimestamp(scanner.Text())
+		emit(line)
+	}
+}
+
+// splitTimestamp separates the RFC3339 timestamp the kubelet prefixes to every line.
+func splitTimestamp(line string) (time.Time, string) {
+	prefix, message, found := strings.Cut(line, " ")
+	if !found {
+		return time.Time{}, line
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, prefix)
+	if err != nil {
+		return time.Time{}, line
+	}
+	return timestamp, message
+}
@@ -0,0 +1,190 @@
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// logServer is a minimal apiserver serving the web deployment, its pods and
+// their logs. The fake clientset cannot return per-container logs.
+type logServer struct {
+	pods []corev1.Pod
+	// logs maps pod/container to the log returned with timestamps.
+	logs map[string]string
+
+	mu sync.Mutex
+	// requests records the URL of every log request.
+	requests []*url.URL
+}
+
+func (s *logServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.URL.Path == "/apis/apps/v1/namespaces/default/deployments/web":
+		_ = json.NewEncoder(w).Encode(newTestDeployment(1, "nginx"))
+	case r.URL.Path == "/api/v1/namespaces/default/pods":
+		_ = json.NewEncoder(w).Encode(corev1.PodList{Items: s.pods})
+	case strings.HasSuffix(r.URL.Path, "/log"):
+		s.mu.Lock()
+		s.requests = append(s.requests, r.URL)
+		s.mu.Unlock()
+		pod := strings.Split(r.URL.Path, "/")[6]
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(s.logs[pod+"/"+r.URL.Query().Get("container")]))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *logServer) client(t *testing.T) kubernetes.Interface {
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+	return kubernetes.NewForConfigOrDie(&rest.Config{Host: server.URL})
+}
+
+func newTestLogPod(name string, phase corev1.PodPhase, containers ...string) corev1.Pod {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "web"}},
+		Status:     corev1.PodStatus{Phase: phase},
+	}
+	for _, container := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: container})
+	}
+	return pod
+}
+
+func newTestLogServer() *logServer {
+	return &logServer{
+		pods: []corev1.Pod{
+			newTestLogPod("web-1", corev1.PodRunning, "app", "sidecar"),
+			newTestLogPod("web-2", corev1.PodRunning, "app"),
+			newTestLogPod("web-3", corev1.PodPending, "app"),
+		},
+		logs: map[string]string{
+			"web-1/app":     "2023-10-01T12:00:01Z one\n2023-10-01T12:00:04Z four\n",
+			"web-1/sidecar": "2023-10-01T12:00:03Z three\n",
+			"web-2/app":     "2023-10-01T12:00:02Z two\n2023-10-01T12:00:05Z five\n",
+			"web-3/app":     "2023-10-01T12:00:00Z pending\n",
+		},
+	}
+}
+
+func TestGetDeploymentLogs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name          string
+		query         string
+		want          string
+		wantTailLines string
+	}{
+		{
+			name:          "merged by timestamp",
+			want:          "[web-1/app] one\n[web-2/app] two\n[web-1/sidecar] three\n[web-1/app] four\n[web-2/app] five\n",
+			wantTailLines: "100",
+		},
+		{
+			name:          "single container",
+			query:         "?container=app&tailLines=5",
+			want:          "[web-1/app] one\n[web-2/app] two\n[web-1/app] four\n[web-2/app] five\n",
+			wantTailLines: "5",
+		},
+		{
+			name:  "limit bytes instead of the default tail",
+			query: "?container=sidecar&limitBytes=1024",
+			want:  "[web-1/sidecar] three\n",
+		},
+		{
+			name:          "timestamps",
+			query:         "?container=sidecar&timestamps=true",
+			want:          "[web-1/sidecar] 2023-10-01T12:00:03Z three\n",
+			wantTailLines: "100",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestLogServer()
+			route := NewDeploymentRoute(NewDeploymentController(&K8sClient{Client: server.client(t)}))
+			router := gin.New()
+			route.DeploymentRoute(router.Group("/namespaces/:namespace/deployments"))
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/namespaces/default/deployments/web/logs"+test.query, nil))
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("got status %d: %s", recorder.Code, recorder.Body.String())
+			}
+			if got := recorder.Body.String(); got != test.want {
+				t.Errorf("got\n%s\nwant\n%s", got, test.want)
+			}
+			if recorder.Header().Get(logsTruncatedHeader) != "" {
+				t.Errorf("logs reported as truncated")
+			}
+			if len(server.requests) == 0 {
+				t.Fatal("no log was read")
+			}
+			for _, request := range server.requests {
+				if strings.Contains(request.Path, "web-3") {
+					t.Errorf("read the log of a pending pod: %s", request)
+				}
+				if got := request.Query().Get("tailLines"); got != test.wantTailLines {
+					t.Errorf("got tailLines %q, want %q", got, test.wantTailLines)
+				}
+			}
+		})
+	}
+}
+
+func TestLogTargets(t *testing.T) {
+	server := newTestLogServer()
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	controller := NewDeploymentController(&K8sClient{Client: server.client(t)})
+
+	tests := []struct {
+		container string
+		want      []logTarget
+	}{
+		{want: []logTarget{{"web-1", "app"}, {"web-1", "sidecar"}, {"web-2", "app"}}},
+		{container: "sidecar", want: []logTarget{{"web-1", "sidecar"}}},
+		{container: "missing"},
+	}
+	for _, test := range tests {
+		t.Run(test.container, func(t *testing.T) {
+			targets, err := controller.logTargets(ctx, newTestDeployment(1, "nginx"), test.container)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(targets, test.want) {
+				t.Errorf("got %v, want %v", targets, test.want)
+			}
+		})
+	}
+}
+
+func TestCollectTruncates(t *testing.T) {
+	server := newTestLogServer()
+	logs := &logAggregator{client: server.client(t), namespace: "default", concurrency: 1, maxBytes: len("one") + len("four")}
+	lines, truncated := logs.collect(context.Background(), []logTarget{{"web-1", "app"}, {"web-1", "sidecar"}, {"web-2", "app"}})
+	if !truncated {
+		t.Error("got truncated false, want true")
+	}
+	var messages []string
+	for _, line := range lines {
+		messages = append(messages, line.Message)
+	}
+	if got := strings.Join(messages, ","); got != "one,four" {
+		t.Errorf("got messages %s, want one,four", got)
+	}
+}
@@ -0,0 +1,152 @@
+package deployment
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sort"
+	"strconv"
+)
+
+const (
+	revisionAnnotation    = "deployment.kubernetes.io/revision"
+	changeCauseAnnotation = "kubernetes.io/change-cause"
+)
+
+// Revision describes one entry of a deployment's rollout history.
+type Revision struct {
+	Revision    int64       `json:"revision"`
+	ReplicaSet  string      `json:"replicaSet"`
+	ChangeCause string      `json:"changeCause,omitempty"`
+	Images      []string    `json:"images"`
+	Replicas    int32       `json:"replicas"`
+	CreatedAt   metav1.Time `json:"createdAt"`
+	Current     bool        `json:"current"`
+}
+
+type HistoryResponse struct {
+	Deployment      string     `json:"deployment"`
+	CurrentRevision int64      `json:"currentRevision"`
+	Revisions       []Revision `json:"revisions"`
+}
+
+type RollbackRequest struct {
+	// Revision to roll back to, 0 selects the previous revision.
+	Revision int64 `json:"revision"`
+}
+
+// ownedReplicaSets returns the ReplicaSets controlled by the deployment ordered by revision.
+func (dc *Controller) ownedReplicaSets(ctx *gin.Context, deployment *v1.Deployment) ([]v1.ReplicaSet, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	list, err := dc.client(ctx).AppsV1().ReplicaSets(deployment.Namespace).List(ctx.Request.Context(), metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var owned []v1.ReplicaSet
+	for _, rs := range list.Items {
+		if owner := metav1.GetControllerOf(&rs); owner != nil && owner.UID == deployment.UID {
+			owned = append(owned, rs)
+		}
+	}
+	sort.SliceStable(owned, func(i, j int) bool {
+		return revisionOf(&owned[i].ObjectMeta) < revisionOf(&owned[j].ObjectMeta)
+	})
+	return owned, nil
+}
+
+// revisionOf reads the revision annotation, returning 0 when it is missing or invalid.
+func revisionOf(meta *metav1.ObjectMeta) int64 {
+	revision, err := strconv.ParseInt(meta.Annotations[revisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+func containerImages(spec corev1.PodSpec) []string {
+	images := make([]string, 0, len(spec.InitContainers)+len(spec.Containers))
+	for _, c := range spec.InitContainers {
+		images = append(images, c.Image)
+	}
+	for _, c := range spec.Containers {
+		images = append(images, c.Image)
+	}
+	return images
+}
+
+func newHistory(deployment *v1.Deployment, replicaSets []v1.ReplicaSet) HistoryResponse {
+	current := revisionOf(&deployment.ObjectMeta)
+	history := HistoryResponse{
+		Deployment:      deployment.Name,
+		CurrentRevision: current,
+		Revisions:       make([]Revision, 0, len(replicaSets)),
+	}
+	for _, rs := range replicaSets {
+		revision := revisionOf(&rs.ObjectMeta)
+		history.Revisions = append(history.Revisions, Revision{
+			Revision:    revision,
+			ReplicaSet:  rs.Name,
+			ChangeCause: rs.Annotations[changeCauseAnnotation],
+			Images:      containerImages(rs.Spec.Template.Spec),
+			Replicas:    rs.Status.Replicas,
+			CreatedAt:   rs.CreationTimestamp,
+			Current:     revision == current,
+		})
+	}
+	return history
+}
+
+// rollbackTarget finds the ReplicaSet of the requested revision. Revision 0
+// selects the newest revision older than the current one.
+func rollbackTarget(deployment *v1.Deployment, replicaSets []v1.ReplicaSet, revision int64) (*v1.ReplicaSet, error) {
+	current := revisionOf(&deployment.ObjectMeta)
+	var target *v1.ReplicaSet
+	for i := range replicaSets {
+		rs := &replicaSets[i]
+		rsRevision := revisionOf(&rs.ObjectMeta)
+		if revision == 0 {
+			if rsRevision < current && (target == nil || rsRevision > revisionOf(&target.ObjectMeta)) {
+				target = rs
+			}
+		} else if rsRevision == revision {
+			target = rs
+		}
+	}
+	if target != nil {
+		return target, nil
+	}
+	if revision == 0 {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("deployment %q has no previous revision", deployment.Name))
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Group: v1.GroupName, Resource: "revisions"}, strconv.FormatInt(revision, 10))
+}
+
+// rollbackPatch builds a JSON patch that restores the pod template of rs,
+// dropping the pod-template-hash label the ReplicaSet controller adds.
+func rollbackPatch(deployment *v1.Deployment, rs *v1.ReplicaSet) []map[string]any {
+	template := rs.Spec.Template.DeepCopy()
+	delete(template.Labels, v1.DefaultDeploymentUniqueLabelKey)
+	patch := []map[string]any{
+		{"op": "test", "path": "/metadata/resourceVersion", "value": deployment.ResourceVersion},
+		{"op": "replace", "path": "/spec/template", "value": template},
+	}
+	annotations := map[string]string{}
+	for key, value := range deployment.Annotations {
+		annotations[key] = value
+	}
+	if changeCause, ok := rs.Annotations[changeCauseAnnotation]; ok {
+		annotations[changeCauseAnnotation] = changeCause
+	} else {
+		delete(annotations, changeCauseAnnotation)
+	}
+	return append(patch, map[string]any{"op": "add", "path": "/metadata/annotations", "value": annotations})
+}
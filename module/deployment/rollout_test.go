@@ -0,0 +1,126 @@
+package deployment
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRollbackTarget(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   int64
+		revisions []int64
+		revision  int64
+		want      int64
+		wantErr   func(error) bool
+	}{
+		{name: "previous revision", current: 3, revisions: []int64{1, 2, 3}, want: 2},
+		{name: "previous revision with gaps", current: 5, revisions: []int64{5, 1, 3}, want: 3},
+		{name: "explicit revision", current: 3, revisions: []int64{1, 2, 3}, revision: 1, want: 1},
+		{name: "explicit current revision", current: 3, revisions: []int64{1, 2, 3}, revision: 3, want: 3},
+		{name: "no previous revision", current: 1, revisions: []int64{1}, wantErr: apierrors.IsBadRequest},
+		{name: "unknown revision", current: 3, revisions: []int64{1, 2, 3}, revision: 9, wantErr: apierrors.IsNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var replicaSets []v1.ReplicaSet
+			for _, revision := range test.revisions {
+				replicaSets = append(replicaSets, newTestReplicaSet(revision, "nginx", ""))
+			}
+			target, err := rollbackTarget(newTestDeployment(test.current, "nginx"), replicaSets, test.revision)
+			if test.wantErr != nil {
+				if !test.wantErr(err) {
+					t.Fatalf("got error %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := revisionOf(&target.ObjectMeta); got != test.want {
+				t.Errorf("got revision %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRollbackPatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		deploymentCause string
+		targetCause     string
+		wantCause       string
+	}{
+		{name: "copies the change cause", deploymentCause: "bump to 1.25", targetCause: "initial", wantCause: "initial"},
+		{name: "drops a stale change cause", deploymentCause: "bump to 1.25"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployment := newTestDeployment(2, "nginx:1.25")
+			if test.deploymentCause != "" {
+				deployment.Annotations[changeCauseAnnotation] = test.deploymentCause
+			}
+			target := newTestReplicaSet(1, "nginx:1.24", test.targetCause)
+
+			patch := rollbackPatch(deployment, &target)
+			if len(patch) != 3 {
+				t.Fatalf("got %d operations, want 3", len(patch))
+			}
+			if patch[0]["op"] != "test" || patch[0]["value"] != deployment.ResourceVersion {
+				t.Errorf("first operation %v does not test the resourceVersion", patch[0])
+			}
+			template := patch[1]["value"].(*corev1.PodTemplateSpec)
+			if _, ok := template.Labels[v1.DefaultDeploymentUniqueLabelKey]; ok {
+				t.Errorf("template keeps the %s label: %v", v1.DefaultDeploymentUniqueLabelKey, template.Labels)
+			}
+			if template.Labels["app"] != "web" || template.Spec.Containers[0].Image != "nginx:1.24" {
+				t.Errorf("template does not match the target: %+v", template)
+			}
+			if _, ok := target.Spec.Template.Labels[v1.DefaultDeploymentUniqueLabelKey]; !ok {
+				t.Error("the ReplicaSet template was modified")
+			}
+			annotations := patch[2]["value"].(map[string]string)
+			if got := annotations[changeCauseAnnotation]; got != test.wantCause {
+				t.Errorf("got change cause %q, want %q", got, test.wantCause)
+			}
+			if annotations[revisionAnnotation] != "2" {
+				t.Errorf("other annotations were dropped: %v", annotations)
+			}
+		})
+	}
+}
+
+func TestRollbackDeployment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	previous := newTestReplicaSet(1, "nginx:1.24", "")
+	current := newTestReplicaSet(2, "nginx:1.25", "")
+	client := fake.NewSimpleClientset(newTestDeployment(2, "nginx:1.25"), &previous, &current)
+	route := NewDeploymentRoute(NewDeploymentController(&K8sClient{Client: client}))
+	router := gin.New()
+	route.DeploymentRoute(router.Group("/namespaces/:namespace/deployments"))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/namespaces/default/deployments/web/rollback", strings.NewReader(`{}`)))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("got status %d: %s", recorder.Code, recorder.Body.String())
+	}
+	var result v1.Deployment
+	if err := json.Unmarshal(recorder.Body.Bytes(), &result); err != nil {
+		t.Fatal(err)
+	}
+	if image := result.Spec.Template.Spec.Containers[0].Image; image != "nginx:1.24" {
+		t.Errorf("got image %q, want nginx:1.24", image)
+	}
+	if _, ok := result.Spec.Template.Labels[v1.DefaultDeploymentUniqueLabelKey]; ok {
+		t.Errorf("template keeps the %s label", v1.DefaultDeploymentUniqueLabelKey)
+	}
+}
@@ -10,10 +10,28 @@ func NewDeploymentRoute(deploymentController Controller) Route {
 	return Route{deploymentController}
 }
 
+// AllNamespacesRoute mounts the cross-namespace deployment list, e.g. on /apis/apps/v1/deployments.
+func (r *Route) AllNamespacesRoute(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListAllDeployment)
+}
+
 func (r *Route) DeploymentRoute(router *gin.RouterGroup) {
 	router.GET("", r.controller.ListDeployment)
+	router.POST("", r.controller.CreateDeployment)
 	router.GET(":name", r.controller.GetDeployment)
+	router.PUT(":name", r.controller.ReplaceDeployment)
+	router.PATCH(":name", r.controller.PatchDeployment)
 	router.DELETE(":name", r.controller.DeleteDeployment)
 	router.PUT(":name/:replica", r.controller.UpdateDeploymentReplica)
 	router.GET(":name/scale", r.controller.ReadDeploymentScale)
+	router.PUT(":name/scale", r.controller.ReplaceDeploymentScale)
+	router.PATCH(":name/scale", r.controller.PatchDeploymentScale)
+	router.GET(":name/logs", r.controller.GetDeploymentLogs)
+	router.POST(":name/restart", r.controller.RestartDeployment)
+	router.POST(":name/pause", r.controller.PauseDeployment)
+	router.POST(":name/resume", r.controller.ResumeDeployment)
+	router.GET(":name/history", r.controller.GetDeploymentHistory)
+	router.POST(":name/rollback", r.controller.RollbackDeployment)
+	router.GET(":name/status", r.controller.GetDeploymentRolloutStatus)
+	router.PATCH(":name/images", r.controller.SetDeploymentImage)
 }
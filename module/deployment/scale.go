@@ -0,0 +1,56 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"time"
+)
+
+const scalePollInterval = time.Second
+
+// validateScale checks a Scale body sent for the deployment name.
+func validateScale(scale *autoscalingv1.Scale, name string) error {
+	var errs field.ErrorList
+	if scale.Name != "" && scale.Name != name {
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), scale.Name, fmt.Sprintf("does not match the name %q in the request path", name)))
+	}
+	if scale.Spec.Replicas < 0 {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "replicas"), scale.Spec.Replicas, "must be greater than or equal to 0"))
+	}
+	if len(errs) > 0 {
+		return apierrors.NewInvalid(autoscalingv1.SchemeGroupVersion.WithKind("Scale").GroupKind(), name, errs)
+	}
+	return nil
+}
+
+// waitForReadyReplicas polls the deployment until the controller observed the
+// latest spec and status.readyReplicas matches the desired replicas.
+func (dc *Controller) waitForReadyReplicas(ctx *gin.Context, namespace, name string, timeout time.Duration) (*autoscalingv1.Scale, error) {
+	client := dc.client(ctx).AppsV1().Deployments(namespace)
+	var scale *autoscalingv1.Scale
+	err := wait.PollUntilContextTimeout(ctx.Request.Context(), scalePollInterval, timeout, true, func(pollCtx context.Context) (bool, error) {
+		deployment, err := client.Get(pollCtx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		if deployment.Status.ObservedGeneration < deployment.Generation || deployment.Status.ReadyReplicas != desired {
+			return false, nil
+		}
+		scale, err = client.GetScale(pollCtx, name, metav1.GetOptions{})
+		return err == nil, err
+	})
+	if err != nil && wait.Interrupted(err) {
+		return nil, apierrors.NewTimeoutError(fmt.Sprintf("timed out waiting for deployment %q to reach its desired ready replicas", name), 0)
+	}
+	return scale, err
+}
@@ -0,0 +1,176 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+	"time"
+)
+
+const (
+	RolloutProgressing = "progressing"
+	RolloutComplete    = "complete"
+	RolloutFailed      = "failed"
+	RolloutPaused      = "paused"
+
+	// timedOutReason is reported by the Progressing condition once progressDeadlineSeconds is exceeded.
+	timedOutReason = "ProgressDeadlineExceeded"
+
+	defaultRolloutTimeout = 5 * time.Minute
+	maxRolloutTimeout     = 30 * time.Minute
+)
+
+// RolloutStatus is the verdict on a deployment rollout, computed the way
+// kubectl rollout status does.
+type RolloutStatus struct {
+	Deployment         string                   `json:"deployment"`
+	State              string                   `json:"state"`
+	Message            string                   `json:"message"`
+	Done               bool                     `json:"done"`
+	TimedOut           bool                     `json:"timedOut,omitempty"`
+	Revision           int64                    `json:"revision"`
+	Generation         int64                    `json:"generation"`
+	ObservedGeneration int64                    `json:"observedGeneration"`
+	DesiredReplicas    int32                    `json:"desiredReplicas"`
+	UpdatedReplicas    int32                    `json:"updatedReplicas"`
+	ReadyReplicas      int32                    `json:"readyReplicas"`
+	AvailableReplicas  int32                    `json:"availableReplicas"`
+	Conditions         []v1.DeploymentCondition `json:"conditions,omitempty"`
+}
+
+// newRolloutStatus evaluates the rollout state of deployment.
+func newRolloutStatus(deployment *v1.Deployment) RolloutStatus {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	status := RolloutStatus{
+		Deployment:         deployment.Name,
+		Revision:           revisionOf(&deployment.ObjectMeta),
+		Generation:         deployment.Generation,
+		ObservedGeneration: deployment.Status.ObservedGeneration,
+		DesiredReplicas:    desired,
+		UpdatedReplicas:    deployment.Status.UpdatedReplicas,
+		ReadyReplicas:      deployment.Status.ReadyReplicas,
+		AvailableReplicas:  deployment.Status.AvailableReplicas,
+		Conditions:         deployment.Status.Conditions,
+	}
+	status.State, status.Message = rolloutState(deployment, desired)
+	status.Done = status.State != RolloutProgressing
+	return status
+}
+
+func rolloutState(deployment *v1.Deployment, desired int32) (string, string) {
+	if deployment.Spec.Paused {
+		return RolloutPaused, fmt.Sprintf("deployment %q is paused", deployment.Name)
+	}
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return RolloutProgressing, "waiting for deployment spec update to be observed"
+	}
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == v1.DeploymentProgressing && condition.Reason == timedOutReason {
+			return RolloutFailed, fmt.Sprintf("deployment %q exceeded its progress deadline", deployment.Name)
+		}
+	}
+	status := deployment.Status
+	if status.UpdatedReplicas < desired {
+		return RolloutProgressing, fmt.Sprintf("%d out of %d new replicas have been updated", status.UpdatedReplicas, desired)
+	}
+	if status.Replicas > status.UpdatedReplicas {
+		return RolloutProgressing, fmt.Sprintf("%d old replicas are pending termination", status.Replicas-status.UpdatedReplicas)
+	}
+	if status.AvailableReplicas < status.UpdatedReplicas {
+		return RolloutProgressing, fmt.Sprintf("%d of %d updated replicas are available", status.AvailableReplicas, status.UpdatedReplicas)
+	}
+	available := false
+	for _, condition := range status.Conditions {
+		if condition.Type == v1.DeploymentAvailable && condition.Status == corev1.ConditionTrue {
+			available = true
+		}
+	}
+	if !available && desired > 0 && len(status.Conditions) > 0 {
+		return RolloutProgressing, "waiting for the deployment to become available"
+	}
+	return RolloutComplete, fmt.Sprintf("deployment %q successfully rolled out", deployment.Name)
+}
+
+// waitForRollout watches the deployment until the rollout reaches a terminal
+// state or ctx expires, calling onUpdate for every observed state. It returns
+// the last observed status; on timeout that status has TimedOut set.
+func (dc *Controller) waitForRollout(ctx *gin.Context, runCtx context.Context, namespace, name string, onUpdate func(RolloutStatus)) (RolloutStatus, error) {
+	client := dc.client(ctx).AppsV1().Deployments(namespace)
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return client.List(runCtx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return client.Watch(runCtx, options)
+		},
+	}
+	var last RolloutStatus
+	seen := false
+	precondition := func(store cache.Store) (bool, error) {
+		_, exists, err := store.GetByKey(namespace + "/" + name)
+		if err != nil {
+			return true, err
+		}
+		if !exists {
+			return true, apierrors.NewNotFound(v1.Resource("deployments"), name)
+		}
+		return false, nil
+	}
+	_, err := watchtools.UntilWithSync(runCtx, lw, &v1.Deployment{}, precondition, func(event watch.Event) (bool, error) {
+		switch event.Type {
+		case watch.Deleted:
+			if deployment, ok := event.Object.(*v1.Deployment); ok && deployment.Name != name {
+				return false, nil
+			}
+			return false, apierrors.NewNotFound(v1.Resource("deployments"), name)
+		case watch.Added, watch.Modified:
+			deployment, ok := event.Object.(*v1.Deployment)
+			if !ok || deployment.Name != name {
+				return false, nil
+			}
+			status := newRolloutStatus(deployment)
+			if !seen || status.Message != last.Message || status.State != last.State {
+				onUpdate(status)
+			}
+			last, seen = status, true
+			return status.Done, nil
+		}
+		return false, nil
+	})
+	if err != nil && runCtx.Err() != nil && seen {
+		last.TimedOut = true
+		return last, nil
+	}
+	return last, err
+}
+
+// parseRolloutTimeout reads the timeout query parameter used when waiting for a rollout.
+func parseRolloutTimeout(ctx *gin.Context) (time.Duration, error) {
+	value := ctx.Query("timeout")
+	if value == "" {
+		return defaultRolloutTimeout, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("timeout must be a duration such as 90s: %w", err)
+	}
+	if timeout <= 0 || timeout > maxRolloutTimeout {
+		return 0, fmt.Errorf("timeout must be between 0s and %s", maxRolloutTimeout)
+	}
+	return timeout, nil
+}
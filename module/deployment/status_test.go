@@ -0,0 +1,134 @@
+package deployment
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRolloutState(t *testing.T) {
+	available := v1.DeploymentCondition{Type: v1.DeploymentAvailable, Status: corev1.ConditionTrue}
+	unavailable := v1.DeploymentCondition{Type: v1.DeploymentAvailable, Status: corev1.ConditionFalse}
+	timedOut := v1.DeploymentCondition{Type: v1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: timedOutReason}
+	tests := []struct {
+		name        string
+		replicas    *int32
+		paused      bool
+		generation  int64
+		status      v1.DeploymentStatus
+		wantState   string
+		wantMessage string
+	}{
+		{
+			name:        "paused",
+			paused:      true,
+			generation:  2,
+			wantState:   RolloutPaused,
+			wantMessage: "is paused",
+		},
+		{
+			name:        "spec update not observed",
+			generation:  2,
+			status:      v1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			wantState:   RolloutProgressing,
+			wantMessage: "waiting for deployment spec update to be observed",
+		},
+		{
+			name:        "progress deadline exceeded",
+			generation:  1,
+			status:      v1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 1, Conditions: []v1.DeploymentCondition{timedOut}},
+			wantState:   RolloutFailed,
+			wantMessage: "exceeded its progress deadline",
+		},
+		{
+			name:        "replicas not yet updated",
+			generation:  1,
+			status:      v1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 1},
+			wantState:   RolloutProgressing,
+			wantMessage: "1 out of 3 new replicas have been updated",
+		},
+		{
+			name:        "old replicas terminating",
+			generation:  1,
+			status:      v1.DeploymentStatus{ObservedGeneration: 1, Replicas: 4, UpdatedReplicas: 3},
+			wantState:   RolloutProgressing,
+			wantMessage: "1 old replicas are pending termination",
+		},
+		{
+			name:        "updated replicas not available",
+			generation:  1,
+			status:      v1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 2},
+			wantState:   RolloutProgressing,
+			wantMessage: "2 of 3 updated replicas are available",
+		},
+		{
+			name:        "available condition false",
+			generation:  1,
+			status:      v1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3, Conditions: []v1.DeploymentCondition{unavailable}},
+			wantState:   RolloutProgressing,
+			wantMessage: "waiting for the deployment to become available",
+		},
+		{
+			name:        "complete",
+			generation:  1,
+			status:      v1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3, Conditions: []v1.DeploymentCondition{available}},
+			wantState:   RolloutComplete,
+			wantMessage: "successfully rolled out",
+		},
+		{
+			name:        "complete without conditions",
+			generation:  1,
+			status:      v1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			wantState:   RolloutComplete,
+			wantMessage: "successfully rolled out",
+		},
+		{
+			name:        "scaled to zero",
+			replicas:    ptr.To[int32](0),
+			generation:  1,
+			status:      v1.DeploymentStatus{ObservedGeneration: 1, Conditions: []v1.DeploymentCondition{unavailable}},
+			wantState:   RolloutComplete,
+			wantMessage: "successfully rolled out",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployment := newTestDeployment(1, "nginx")
+			replicas := int32(3)
+			if test.replicas != nil {
+				replicas = *test.replicas
+			}
+			deployment.Spec.Replicas = ptr.To(replicas)
+			deployment.Spec.Paused = test.paused
+			deployment.Generation = test.generation
+			deployment.Status = test.status
+
+			status := newRolloutStatus(deployment)
+			if status.State != test.wantState {
+				t.Errorf("got state %q, want %q (%s)", status.State, test.wantState, status.Message)
+			}
+			if !strings.Contains(status.Message, test.wantMessage) {
+				t.Errorf("got message %q, want it to contain %q", status.Message, test.wantMessage)
+			}
+			if wantDone := test.wantState != RolloutProgressing; status.Done != wantDone {
+				t.Errorf("got done %v, want %v", status.Done, wantDone)
+			}
+			if status.DesiredReplicas != replicas {
+				t.Errorf("got desired replicas %d, want %d", status.DesiredReplicas, replicas)
+			}
+		})
+	}
+}
+
+func TestRolloutStateDefaultsToOneReplica(t *testing.T) {
+	deployment := newTestDeployment(1, "nginx")
+	deployment.Generation = 1
+	deployment.Status = v1.DeploymentStatus{ObservedGeneration: 1}
+	status := newRolloutStatus(deployment)
+	if status.DesiredReplicas != 1 || status.State != RolloutProgressing {
+		t.Errorf("got %d desired replicas in state %q, want 1 in %q", status.DesiredReplicas, status.State, RolloutProgressing)
+	}
+}
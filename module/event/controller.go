@@ -0,0 +1,297 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	"github.com/jobayer12/go-kubernetes/module/common"
+	v1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"net/http"
+)
+
+type ListEventResponse struct {
+	v1.EventList `json:",inline"`
+}
+
+type ListEventV1Response struct {
+	eventsv1.EventList `json:",inline"`
+}
+
+// apiVersionEventsV1 selects the events.k8s.io/v1 representation on the object sub-routes.
+const apiVersionEventsV1 = "events.k8s.io/v1"
+
+type K8sClient struct {
+	Client kubernetes.Interface
+	Config *rest.Config
+	Cache  *cluster.Cache
+}
+
+type Controller struct {
+	*K8sClient
+}
+
+func NewEventController(k8sClient *K8sClient) Controller {
+	return Controller{K8sClient: k8sClient}
+}
+
+// client returns the clientset of the cluster the request targets.
+func (k *K8sClient) client(ctx *gin.Context) kubernetes.Interface {
+	return cluster.Client(ctx, k.Client)
+}
+
+// ListEvent
+// @Summary			Get the List of Event.
+// @Description		Return core/v1 events of a namespace, newest first.
+// @Tags			event
+// @Router			/api/v1/namespaces/{namespace}/events [get]
+// @Param 			namespace path string true "Namespace, _all lists every namespace" default(default)
+// @Param 			kind query string false "Kind of the involved object, e.g. Pod"
+// @Param 			name query string false "Name of the involved object"
+// @Param 			uid query string false "UID of the involved object"
+// @Param 			type query string false "Event type" Enums(Normal, Warning)
+// @Param 			reason query string false "Event reason, e.g. BackOff"
+// @Param 			since query string false "Only return events last seen within this duration, e.g. 1h; cannot be combined with limit"
+// @Param 			labelSelector query string false "Label selector"
+// @Param 			fieldSelector query string false "Additional field selector"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page. Events are sorted within each page; cannot be combined with since"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} ListEventResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (ec *Controller) ListEvent(ctx *gin.Context) {
+	ec.listCore(ctx, common.NamespaceParam(ctx), nil)
+}
+
+// ListAllEvent
+// @Summary			Get the List of Event in all namespaces.
+// @Description		Return core/v1 events across every namespace, newest first.
+// @Tags			event
+// @Router			/api/v1/events [get]
+// @Param 			kind query string false "Kind of the involved object, e.g. Pod"
+// @Param 			name query string false "Name of the involved object"
+// @Param 			uid query string false "UID of the involved object"
+// @Param 			type query string false "Event type" Enums(Normal, Warning)
+// @Param 			reason query string false "Event reason, e.g. BackOff"
+// @Param 			since query string false "Only return events last seen within this duration, e.g. 1h; cannot be combined with limit"
+// @Param 			labelSelector query string false "Label selector"
+// @Param 			fieldSelector query string false "Additional field selector"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page. Events are sorted within each page; cannot be combined with since"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} ListEventResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (ec *Controller) ListAllEvent(ctx *gin.Context) {
+	ec.listCore(ctx, metav1.NamespaceAll, nil)
+}
+
+// ListEventV1
+// @Summary			Get the List of events.k8s.io Event.
+// @Description		Return events.k8s.io/v1 events of a namespace, newest first. The kind, name and uid filters match the regarding object.
+// @Tags			event
+// @Router			/apis/events.k8s.io/v1/namespaces/{namespace}/events [get]
+// @Param 			namespace path string true "Namespace, _all lists every namespace" default(default)
+// @Param 			kind query string false "Kind of the regarding object, e.g. Pod"
+// @Param 			name query string false "Name of the regarding object"
+// @Param 			uid query string false "UID of the regarding object"
+// @Param 			type query string false "Event type" Enums(Normal, Warning)
+// @Param 			reason query string false "Event reason, e.g. BackOff"
+// @Param 			since query string false "Only return events last seen within this duration, e.g. 1h; cannot be combined with limit"
+// @Param 			labelSelector query string false "Label selector"
+// @Param 			fieldSelector query string false "Additional field selector"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page. Events are sorted within each page; cannot be combined with since"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} ListEventV1Response
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (ec *Controller) ListEventV1(ctx *gin.Context) {
+	ec.listV1(ctx, common.NamespaceParam(ctx), nil)
+}
+
+// ListAllEventV1
+// @Summary			Get the List of events.k8s.io Event in all namespaces.
+// @Description		Return events.k8s.io/v1 events across every namespace, newest first.
+// @Tags			event
+// @Router			/apis/events.k8s.io/v1/events [get]
+// @Param 			kind query string false "Kind of the regarding object, e.g. Pod"
+// @Param 			name query string false "Name of the regarding object"
+// @Param 			uid query string false "UID of the regarding object"
+// @Param 			type query string false "Event type" Enums(Normal, Warning)
+// @Param 			reason query string false "Event reason, e.g. BackOff"
+// @Param 			since query string false "Only return events last seen within this duration, e.g. 1h; cannot be combined with limit"
+// @Param 			labelSelector query string false "Label selector"
+// @Param 			fieldSelector query string false "Additional field selector"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page. Events are sorted within each page; cannot be combined with since"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} ListEventV1Response
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (ec *Controller) ListAllEventV1(ctx *gin.Context) {
+	ec.listV1(ctx, metav1.NamespaceAll, nil)
+}
+
+// ListPodEvent
+// @Summary			Get the events of a Pod.
+// @Description		Return the events of a pod, matched by its UID so events of an earlier pod with the same name are left out.
+// @Tags			event
+// @Router			/api/v1/namespaces/{namespace}/pods/{podName}/events [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			podName path string true "Pod name"
+// @Param 			apiVersion query string false "Event API version to return" Enums(v1, events.k8s.io/v1) default(v1)
+// @Param 			type query string false "Event type" Enums(Normal, Warning)
+// @Param 			reason query string false "Event reason, e.g. BackOff"
+// @Param 			since query string false "Only return events last seen within this duration, e.g. 1h"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} ListEventResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (ec *Controller) ListPodEvent(ctx *gin.Context) {
+	ec.listObjectEvents(ctx, "Pod", ctx.Param("podName"), func(c context.Context, namespace, name string) (types.UID, error) {
+		pod, err := ec.client(ctx).CoreV1().Pods(namespace).Get(c, name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return pod.UID, nil
+	})
+}
+
+// ListDeploymentEvent
+// @Summary			Get the events of a deployment.
+// @Description		Return the events of a deployment, matched by its UID. Events of its ReplicaSets and pods are not included.
+// @Tags			event
+// @Router			/apis/apps/v1/{namespace}/deployments/{name}/events [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Deployment name"
+// @Param 			apiVersion query string false "Event API version to return" Enums(v1, events.k8s.io/v1) default(v1)
+// @Param 			type query string false "Event type" Enums(Normal, Warning)
+// @Param 			reason query string false "Event reason, e.g. ScalingReplicaSet"
+// @Param 			since query string false "Only return events last seen within this duration, e.g. 1h"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} ListEventResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (ec *Controller) ListDeploymentEvent(ctx *gin.Context) {
+	ec.listObjectEvents(ctx, "Deployment", ctx.Param("name"), func(c context.Context, namespace, name string) (types.UID, error) {
+		deployment, err := ec.client(ctx).AppsV1().Deployments(namespace).Get(c, name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return deployment.UID, nil
+	})
+}
+
+// listObjectEvents resolves the UID of the named object and lists its events
+// in the API version selected by the apiVersion query parameter.
+func (ec *Controller) listObjectEvents(ctx *gin.Context, kind, name string, resolve func(context.Context, string, string) (types.UID, error)) {
+	namespace := ctx.Param("namespace")
+	apiVersion := ctx.DefaultQuery("apiVersion", "v1")
+	if apiVersion != "v1" && apiVersion != apiVersionEventsV1 {
+		common.BadRequest(ctx, errors.New("apiVersion must be v1 or "+apiVersionEventsV1))
+		return
+	}
+	uid, err := resolve(ctx.Request.Context(), namespace, name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	object := &objectReference{kind: kind, name: name, uid: uid}
+	if apiVersion == apiVersionEventsV1 {
+		ec.listV1(ctx, namespace, object)
+		return
+	}
+	ec.listCore(ctx, namespace, object)
+}
+
+// objectReference pins the object filters of a sub-route, overriding the query parameters.
+type objectReference struct {
+	kind string
+	name string
+	uid  types.UID
+}
+
+func (ec *Controller) listCore(ctx *gin.Context, namespace string, object *objectReference) {
+	query, err := ec.parseQuery(ctx, "involvedObject", object)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	events := ec.client(ctx).CoreV1().Events(namespace)
+	if query.watch {
+		common.StreamWatch(ctx, common.ListWatch{
+			List: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				return events.List(ctx, options)
+			},
+			Watch: events.Watch,
+		}, query.WatchOptions())
+		return
+	}
+	list, err := events.List(ctx.Request.Context(), query.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, query.filterCore(list))
+}
+
+func (ec *Controller) listV1(ctx *gin.Context, namespace string, object *objectReference) {
+	query, err := ec.parseQuery(ctx, "regarding", object)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	events := ec.client(ctx).EventsV1().Events(namespace)
+	if query.watch {
+		common.StreamWatch(ctx, common.ListWatch{
+			List: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				return events.List(ctx, options)
+			},
+			Watch: events.Watch,
+		}, query.WatchOptions())
+		return
+	}
+	list, err := events.List(ctx.Request.Context(), query.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, query.filterV1(list))
+}
+
+type listRequest struct {
+	Query
+	watch bool
+}
+
+// parseQuery reads the filters and watch flag of a list request. The time
+// window cannot be applied to a watch, so since and watch are exclusive.
+func (ec *Controller) parseQuery(ctx *gin.Context, prefix string, object *objectReference) (listRequest, error) {
+	query, err := ParseQuery(ctx)
+	if err != nil {
+		return listRequest{}, err
+	}
+	if object != nil {
+		query.Kind, query.Name, query.UID = object.kind, object.name, string(object.uid)
+	}
+	query, err = query.withFieldSelector(prefix)
+	if err != nil {
+		return listRequest{}, err
+	}
+	watch, err := common.WantsWatch(ctx)
+	if err != nil {
+		return listRequest{}, err
+	}
+	if watch && query.Since != 0 {
+		return listRequest{}, errors.New("since is not supported with watch=true")
+	}
+	return listRequest{Query: query, watch: watch}, nil
+}
@@ -0,0 +1,116 @@
+package event
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestListObjectEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name      string
+		path      string
+		wantCode  int
+		wantGroup string
+		want      fields.Set
+	}{
+		{
+			name:     "pod",
+			path:     "/namespaces/default/pods/web/events?type=Warning",
+			wantCode: http.StatusOK,
+			want:     fields.Set{"involvedObject.kind": "Pod", "involvedObject.name": "web", "involvedObject.uid": "pod-uid", "type": "Warning"},
+		},
+		{
+			name:     "pod filters override the query",
+			path:     "/namespaces/default/pods/web/events?name=other&uid=other-uid",
+			wantCode: http.StatusOK,
+			want:     fields.Set{"involvedObject.kind": "Pod", "involvedObject.name": "web", "involvedObject.uid": "pod-uid"},
+		},
+		{
+			name:      "pod events.k8s.io/v1",
+			path:      "/namespaces/default/pods/web/events?apiVersion=events.k8s.io/v1",
+			wantCode:  http.StatusOK,
+			wantGroup: "events.k8s.io",
+			want:      fields.Set{"regarding.kind": "Pod", "regarding.name": "web", "regarding.uid": "pod-uid"},
+		},
+		{
+			name:     "deployment",
+			path:     "/namespaces/default/deployments/web/events?reason=ScalingReplicaSet",
+			wantCode: http.StatusOK,
+			want:     fields.Set{"involvedObject.kind": "Deployment", "involvedObject.name": "web", "involvedObject.uid": "deployment-uid", "reason": "ScalingReplicaSet"},
+		},
+		{
+			name:     "missing pod",
+			path:     "/namespaces/default/pods/api/events",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "unknown api version",
+			path:     "/namespaces/default/pods/web/events?apiVersion=v2",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "since with watch",
+			path:     "/namespaces/default/pods/web/events?since=1h&watch=true",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "pod-uid"}},
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "deployment-uid"}},
+			)
+			route := NewEventRoute(NewEventController(&K8sClient{Client: client}))
+			router := gin.New()
+			route.PodRoute(router.Group("/namespaces/:namespace/pods"))
+			route.DeploymentRoute(router.Group("/namespaces/:namespace/deployments"))
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, test.path, nil))
+			if recorder.Code != test.wantCode {
+				t.Fatalf("got status %d, want %d: %s", recorder.Code, test.wantCode, recorder.Body.String())
+			}
+			if test.want == nil {
+				return
+			}
+			var list k8stesting.ListAction
+			for _, action := range client.Actions() {
+				if action.GetVerb() == "list" && action.GetResource().Resource == "events" {
+					list = action.(k8stesting.ListAction)
+				}
+			}
+			if list == nil {
+				t.Fatal("events were not listed")
+			}
+			if group := list.GetResource().Group; group != test.wantGroup {
+				t.Errorf("got API group %q, want %q", group, test.wantGroup)
+			}
+			if selector := list.GetListRestrictions().Fields; !sameRequirements(selector, test.want) {
+				t.Errorf("got field selector %q, want %v", selector, test.want)
+			}
+		})
+	}
+}
+
+func TestListEventRejectsSinceWithLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	route := NewEventRoute(NewEventController(&K8sClient{Client: fake.NewSimpleClientset()}))
+	router := gin.New()
+	route.Route(router.Group("/namespaces/:namespace/events"))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/namespaces/default/events?since=1h&limit=10", nil))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("got status %d, want %d", recorder.Code, http.StatusBadRequest)
+	}
+}
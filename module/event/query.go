@@ -0,0 +1,154 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/common"
+	v1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"sort"
+	"time"
+)
+
+// Query holds the filters of an event list. Object, type and reason filters
+// are sent to the apiserver as field selectors; the time window is applied here
+// and therefore cannot be combined with pagination.
+type Query struct {
+	common.ListQuery
+	Kind   string
+	Name   string
+	UID    string
+	Type   string
+	Reason string
+	// Since keeps events last seen within this duration, 0 keeps every event.
+	Since time.Duration
+}
+
+// ParseQuery reads the list parameters and the kind, name, uid, type, reason and since filters.
+func ParseQuery(ctx *gin.Context) (Query, error) {
+	list, err := common.ParseListQuery(ctx)
+	if err != nil {
+		return Query{}, err
+	}
+	query := Query{
+		ListQuery: list,
+		Kind:      ctx.Query("kind"),
+		Name:      ctx.Query("name"),
+		UID:       ctx.Query("uid"),
+		Type:      ctx.Query("type"),
+		Reason:    ctx.Query("reason"),
+	}
+	if query.Type != "" && query.Type != v1.EventTypeNormal && query.Type != v1.EventTypeWarning {
+		return query, fmt.Errorf("type must be %s or %s, got %q", v1.EventTypeNormal, v1.EventTypeWarning, query.Type)
+	}
+	if value := ctx.Query("since"); value != "" {
+		since, err := time.ParseDuration(value)
+		if err != nil || since <= 0 {
+			return query, fmt.Errorf("since must be a positive duration such as 1h, got %q", value)
+		}
+		query.Since = since
+	}
+	// The window is applied to the page the apiserver returns, so with
+	// pagination a page could come back short while more events remain.
+	if query.Since != 0 && query.Options.Limit != 0 {
+		return query, errors.New("since is not supported with limit")
+	}
+	return query, nil
+}
+
+// withFieldSelector adds the object, type and reason filters to the field
+// selector of the list options. prefix is the object reference field of the
+// API version, involvedObject for core/v1 and regarding for events.k8s.io/v1.
+func (q Query) withFieldSelector(prefix string) (Query, error) {
+	set := fields.Set{}
+	for field, value := range map[string]string{
+		prefix + ".kind": q.Kind,
+		prefix + ".name": q.Name,
+		prefix + ".uid":  q.UID,
+		"type":           q.Type,
+		"reason":         q.Reason,
+	} {
+		if value != "" {
+			set[field] = value
+		}
+	}
+	selectors := []fields.Selector{fields.SelectorFromSet(set)}
+	if q.Options.FieldSelector != "" {
+		selector, err := fields.ParseSelector(q.Options.FieldSelector)
+		if err != nil {
+			return q, err
+		}
+		selectors = append(selectors, selector)
+	}
+	q.Options.FieldSelector = fields.AndSelectors(selectors...).String()
+	return q, nil
+}
+
+// LastSeen returns when a core/v1 event was last observed, whichever fields its reporter filled in.
+func LastSeen(event v1.Event) time.Time {
+	switch {
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// lastSeenV1 returns when an events.k8s.io/v1 event was last observed.
+func lastSeenV1(event eventsv1.Event) time.Time {
+	switch {
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.DeprecatedLastTimestamp.IsZero():
+		return event.DeprecatedLastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// filterCore applies the time window and sorts the events newest first.
+func (q Query) filterCore(list *v1.EventList) *v1.EventList {
+	items := make([]v1.Event, 0, len(list.Items))
+	cutoff := q.cutoff()
+	for _, event := range list.Items {
+		if !LastSeen(event).Before(cutoff) {
+			items = append(items, event)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return LastSeen(items[i]).After(LastSeen(items[j]))
+	})
+	list.Items = items
+	return list
+}
+
+// filterV1 applies the time window and sorts the events newest first.
+func (q Query) filterV1(list *eventsv1.EventList) *eventsv1.EventList {
+	items := make([]eventsv1.Event, 0, len(list.Items))
+	cutoff := q.cutoff()
+	for _, event := range list.Items {
+		if !lastSeenV1(event).Before(cutoff) {
+			items = append(items, event)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return lastSeenV1(items[i]).After(lastSeenV1(items[j]))
+	})
+	list.Items = items
+	return list
+}
+
+func (q Query) cutoff() time.Time {
+	if q.Since == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(-q.Since)
+}
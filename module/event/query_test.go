@@ -0,0 +1,256 @@
+package event
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+func parseTestQuery(t *testing.T, query string) (Query, error) {
+	t.Helper()
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/?"+query, nil)
+	return ParseQuery(ctx)
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		wantSince time.Duration
+		wantErr   string
+	}{
+		{name: "empty"},
+		{name: "since", query: "since=90m", wantSince: 90 * time.Minute},
+		{name: "type", query: "type=Warning"},
+		{name: "pagination", query: "limit=10&continue=token"},
+		{name: "invalid type", query: "type=Error", wantErr: "type must be Normal or Warning"},
+		{name: "invalid since", query: "since=yesterday", wantErr: "since must be a positive duration"},
+		{name: "negative since", query: "since=-1h", wantErr: "since must be a positive duration"},
+		{name: "since with limit", query: "since=1h&limit=10", wantErr: "since is not supported with limit"},
+		{name: "since with continue", query: "since=1h&limit=10&continue=token", wantErr: "since is not supported with limit"},
+		{name: "invalid list query", query: "continue=token", wantErr: "continue requires limit"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, err := parseTestQuery(t, test.query)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if query.Since != test.wantSince {
+				t.Errorf("got since %s, want %s", query.Since, test.wantSince)
+			}
+		})
+	}
+}
+
+func TestWithFieldSelector(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		prefix string
+		want   fields.Set
+	}{
+		{
+			name:   "no filters",
+			prefix: "involvedObject",
+			want:   fields.Set{},
+		},
+		{
+			name:   "core object filters",
+			query:  "kind=Pod&name=web&uid=123",
+			prefix: "involvedObject",
+			want:   fields.Set{"involvedObject.kind": "Pod", "involvedObject.name": "web", "involvedObject.uid": "123"},
+		},
+		{
+			name:   "regarding object filters",
+			query:  "kind=Pod&name=web",
+			prefix: "regarding",
+			want:   fields.Set{"regarding.kind": "Pod", "regarding.name": "web"},
+		},
+		{
+			name:   "type and reason",
+			query:  "type=Warning&reason=BackOff",
+			prefix: "involvedObject",
+			want:   fields.Set{"type": "Warning", "reason": "BackOff"},
+		},
+		{
+			name:   "combined with the field selector",
+			query:  "reason=BackOff&fieldSelector=source%3Dkubelet",
+			prefix: "involvedObject",
+			want:   fields.Set{"reason": "BackOff", "source": "kubelet"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, err := parseTestQuery(t, test.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			query, err = query.withFieldSelector(test.prefix)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			selector, err := fields.ParseSelector(query.Options.FieldSelector)
+			if err != nil {
+				t.Fatalf("invalid field selector %q: %v", query.Options.FieldSelector, err)
+			}
+			if !sameRequirements(selector, test.want) {
+				t.Errorf("got field selector %q, want %v", selector, test.want)
+			}
+		})
+	}
+}
+
+// sameRequirements reports whether selector requires exactly the fields of want.
+func sameRequirements(selector fields.Selector, want fields.Set) bool {
+	requirements := selector.Requirements()
+	if len(requirements) != len(want) {
+		return false
+	}
+	for _, requirement := range requirements {
+		if want[requirement.Field] != requirement.Value {
+			return false
+		}
+	}
+	return true
+}
+
+func TestLastSeen(t *testing.T) {
+	created := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	eventTime := created.Add(time.Minute)
+	lastTimestamp := created.Add(2 * time.Minute)
+	observed := created.Add(3 * time.Minute)
+	tests := []struct {
+		name  string
+		event v1.Event
+		want  time.Time
+	}{
+		{
+			name:  "creation timestamp",
+			event: v1.Event{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}},
+			want:  created,
+		},
+		{
+			name: "event time",
+			event: v1.Event{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+				EventTime:  metav1.NewMicroTime(eventTime),
+			},
+			want: eventTime,
+		},
+		{
+			name: "last timestamp",
+			event: v1.Event{
+				ObjectMeta:    metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+				EventTime:     metav1.NewMicroTime(eventTime),
+				LastTimestamp: metav1.NewTime(lastTimestamp),
+			},
+			want: lastTimestamp,
+		},
+		{
+			name: "series",
+			event: v1.Event{
+				LastTimestamp: metav1.NewTime(lastTimestamp),
+				Series:        &v1.EventSeries{Count: 2, LastObservedTime: metav1.NewMicroTime(observed)},
+			},
+			want: observed,
+		},
+		{
+			name: "series without an observed time",
+			event: v1.Event{
+				LastTimestamp: metav1.NewTime(lastTimestamp),
+				Series:        &v1.EventSeries{Count: 2},
+			},
+			want: lastTimestamp,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := LastSeen(test.event); !got.Equal(test.want) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLastSeenV1(t *testing.T) {
+	created := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	eventTime := created.Add(time.Minute)
+	lastTimestamp := created.Add(2 * time.Minute)
+	observed := created.Add(3 * time.Minute)
+	tests := []struct {
+		name  string
+		event eventsv1.Event
+		want  time.Time
+	}{
+		{
+			name:  "creation timestamp",
+			event: eventsv1.Event{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}},
+			want:  created,
+		},
+		{
+			name:  "event time",
+			event: eventsv1.Event{EventTime: metav1.NewMicroTime(eventTime)},
+			want:  eventTime,
+		},
+		{
+			name:  "deprecated last timestamp",
+			event: eventsv1.Event{EventTime: metav1.NewMicroTime(eventTime), DeprecatedLastTimestamp: metav1.NewTime(lastTimestamp)},
+			want:  lastTimestamp,
+		},
+		{
+			name:  "series",
+			event: eventsv1.Event{EventTime: metav1.NewMicroTime(eventTime), Series: &eventsv1.EventSeries{Count: 2, LastObservedTime: metav1.NewMicroTime(observed)}},
+			want:  observed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := lastSeenV1(test.event); !got.Equal(test.want) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFilterCore(t *testing.T) {
+	now := time.Now()
+	newEvent := func(name string, age time.Duration) v1.Event {
+		return v1.Event{ObjectMeta: metav1.ObjectMeta{Name: name}, LastTimestamp: metav1.NewTime(now.Add(-age))}
+	}
+	list := &v1.EventList{Items: []v1.Event{newEvent("old", 2*time.Hour), newEvent("newest", time.Minute), newEvent("recent", 10*time.Minute)}}
+	tests := []struct {
+		since time.Duration
+		want  string
+	}{
+		{want: "newest,recent,old"},
+		{since: time.Hour, want: "newest,recent"},
+	}
+	for _, test := range tests {
+		t.Run(test.since.String(), func(t *testing.T) {
+			input := list.DeepCopy()
+			var names []string
+			for _, event := range (Query{Since: test.since}).filterCore(input).Items {
+				names = append(names, event.Name)
+			}
+			if got := strings.Join(names, ","); got != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package event
+
+import "github.com/gin-gonic/gin"
+
+type Route struct {
+	controller Controller
+}
+
+func NewEventRoute(controller Controller) Route {
+	return Route{controller}
+}
+
+// Route mounts the namespaced event list, e.g. on /api/v1/namespaces/:namespace/events.
+func (r *Route) Route(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListEvent)
+}
+
+// AllNamespacesRoute mounts the cross-namespace event list, e.g. on /api/v1/events.
+func (r *Route) AllNamespacesRoute(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListAllEvent)
+}
+
+// EventsV1Route mounts the events.k8s.io/v1 lists on /apis/events.k8s.io/v1.
+func (r *Route) EventsV1Route(router *gin.RouterGroup) {
+	router.GET("events", r.controller.ListAllEventV1)
+	router.GET("namespaces/:namespace/events", r.controller.ListEventV1)
+}
+
+// PodRoute mounts the events sub-route on the pod routes.
+func (r *Route) PodRoute(router *gin.RouterGroup) {
+	router.GET(":podName/events", r.controller.ListPodEvent)
+}
+
+// DeploymentRoute mounts the events sub-route on the deployment routes.
+func (r *Route) DeploymentRoute(router *gin.RouterGroup) {
+	router.GET(":name/events", r.controller.ListDeploymentEvent)
+}
@@ -0,0 +1,19 @@
+package namespace
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"sort"
+)
+
+// newNamespaceList builds a list response from cached namespaces, ordered by name.
+func newNamespaceList(namespaces []*v1.Namespace, resourceVersion string) *v1.NamespaceList {
+	list := &v1.NamespaceList{Items: make([]v1.Namespace, 0, len(namespaces))}
+	list.ResourceVersion = resourceVersion
+	for _, namespace := range namespaces {
+		list.Items = append(list.Items, *namespace)
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Name < list.Items[j].Name
+	})
+	return list
+}
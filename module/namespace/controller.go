@@ -2,14 +2,29 @@ package namespace
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	"github.com/jobayer12/go-kubernetes/module/common"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"net/http"
+	"sync"
+	"time"
 )
 
 type K8sClient struct {
 	Client kubernetes.Interface
+	Config *rest.Config
+	Cache  *cluster.Cache
 }
 
 type Controller struct {
@@ -20,18 +35,448 @@ func NewNamespaceController(k8sClient *K8sClient) Controller {
 	return Controller{K8sClient: k8sClient}
 }
 
+// client returns the clientset of the cluster the request targets.
+func (k *K8sClient) client(ctx *gin.Context) kubernetes.Interface {
+	return cluster.Client(ctx, k.Client)
+}
+
+// cache returns the read cache of the cluster the request targets.
+func (k *K8sClient) cache(ctx *gin.Context) *cluster.Cache {
+	return cluster.CacheFor(ctx, k.Cache)
+}
+
 // ListNamespace
 // @Summary			Get the List of namespace.
 // @Description		Return list of namespace.
 // @Tags			namespace
 // @Router			/api/v1/namespaces [get]
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. status.phase=Running"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Param 			consistency query string false "Read from the informer cache (cached) or the apiserver (strong)" Enums(cached, strong) default(cached)
 // @Response		200 {object} v1.NamespaceList
-// @Produce			application/json
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
 func (ns *Controller) ListNamespace(ctx *gin.Context) {
-	namespaces, err := ns.Client.CoreV1().Namespaces().List(context.Background(), metav1.ListOptions{})
+	query, err := common.ParseListQuery(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	watch, err := common.WantsWatch(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if watch {
+		namespaces := ns.client(ctx).CoreV1().Namespaces()
+		common.StreamWatch(ctx, common.ListWatch{
+			List: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				return namespaces.List(ctx, options)
+			},
+			Watch: namespaces.Watch,
+		}, query.WatchOptions())
+		return
+	}
+	cached, err := cluster.UseCache(ctx, ns.cache(ctx), query.Cacheable())
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if cached {
+		items, err := ns.cache(ctx).Namespaces().List(query.Labels)
+		if err != nil {
+			common.Error(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, newNamespaceList(items, ns.cache(ctx).NamespacesResourceVersion()))
+		return
+	}
+	namespaces, err := ns.client(ctx).CoreV1().Namespaces().List(ctx.Request.Context(), query.Options)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, err)
+		common.Error(ctx, err)
 		return
 	}
 	ctx.JSON(http.StatusOK, namespaces)
 }
+
+// GetNamespace
+// @Summary			Get namespace by name.
+// @Description		Return namespace.
+// @Tags			namespace
+// @Router			/api/v1/namespaces/{namespace} [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			consistency query string false "Read from the informer cache (cached) or the apiserver (strong)" Enums(cached, strong) default(cached)
+// @Response		200 {object} v1.Namespace
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (ns *Controller) GetNamespace(ctx *gin.Context) {
+	name := ctx.Param("namespace")
+	cached, err := cluster.UseCache(ctx, ns.cache(ctx), true)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if cached {
+		namespace, err := ns.cache(ctx).Namespaces().Get(name)
+		if err != nil {
+			common.Error(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, namespace)
+		return
+	}
+	namespace, err := ns.client(ctx).CoreV1().Namespaces().Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, namespace)
+}
+
+// CreateNamespace
+// @Summary			Create namespace
+// @Description		Create a namespace with the given labels and annotations. With a ttl the namespace is annotated with its expiry and deleted by the reaper once expired.
+// @Tags			namespace
+// @Router			/api/v1/namespaces [post]
+// @Param 			request body CreateNamespaceRequest true "Namespace"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		201 {object} v1.Namespace
+// @Failure			400,401,403,409,422,500 {object} common.ErrorResponse
+// @Accept			application/json
+// @Produce			application/json
+func (ns *Controller) CreateNamespace(ctx *gin.Context) {
+	var request CreateNamespaceRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	namespace := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        request.Name,
+		Labels:      request.Labels,
+		Annotations: request.Annotations,
+	}}
+	if request.TTL != "" {
+		ttl, err := parseTTL("ttl", request.TTL)
+		if err != nil {
+			common.BadRequest(ctx, err)
+			return
+		}
+		if namespace.Annotations == nil {
+			namespace.Annotations = map[string]string{}
+		}
+		namespace.Annotations[ExpiresAtAnnotation] = time.Now().Add(ttl).UTC().Format(time.RFC3339)
+	}
+	result, err := ns.client(ctx).CoreV1().Namespaces().Create(ctx.Request.Context(), namespace, metav1.CreateOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, result)
+}
+
+// PatchNamespace
+// @Summary			Patch namespace
+// @Description		Apply a JSON patch, merge patch, strategic merge patch or server-side apply patch, selected by the Content-Type.
+// @Tags			namespace
+// @Router			/api/v1/namespaces/{namespace} [patch]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			request body object true "Patch document"
+// @Param 			fieldManager query string false "Field manager recorded for the change, required for apply patches" default(go-kubernetes)
+// @Param 			force query bool false "Force an apply patch, taking ownership of conflicting fields"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} v1.Namespace
+// @Failure			400,401,403,404,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json-patch+json,application/merge-patch+json,application/strategic-merge-patch+json,application/apply-patch+yaml
+// @Produce			application/json
+func (ns *Controller) PatchNamespace(ctx *gin.Context) {
+	name := ctx.Param("namespace")
+	patch, err := common.DecodePatch(ctx)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := ns.client(ctx).CoreV1().Namespaces().Patch(ctx.Request.Context(), name, patch.Type, patch.Data, patch.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// UpdateNamespaceMetadata
+// @Summary			Update namespace labels and annotations
+// @Description		Set labels and annotations of a namespace. Keys with a null value are removed, keys not mentioned are kept.
+// @Tags			namespace
+// @Router			/api/v1/namespaces/{namespace}/metadata [patch]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			request body UpdateMetadataRequest true "Labels and annotations"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} v1.Namespace
+// @Failure			400,401,403,404,422,500 {object} common.ErrorResponse
+// @Accept			application/json
+// @Produce			application/json
+func (ns *Controller) UpdateNamespaceMetadata(ctx *gin.Context) {
+	name := ctx.Param("namespace")
+	var request UpdateMetadataRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if len(request.Labels) == 0 && len(request.Annotations) == 0 {
+		common.BadRequest(ctx, errors.New("labels or annotations are required"))
+		return
+	}
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	metadata := map[string]any{}
+	if len(request.Labels) > 0 {
+		metadata["labels"] = request.Labels
+	}
+	if len(request.Annotations) > 0 {
+		metadata["annotations"] = request.Annotations
+	}
+	data, err := json.Marshal(map[string]any{"metadata": metadata})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := ns.client(ctx).CoreV1().Namespaces().Patch(ctx.Request.Context(), name, types.MergePatchType, data, metav1.PatchOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// DeleteNamespace
+// @Summary			Delete namespace
+// @Description		Start deleting a namespace. Returns 202 with the Terminating phase and what still blocks the removal; poll the status endpoint to follow it.
+// @Tags			namespace
+// @Router			/api/v1/namespaces/{namespace} [delete]
+// @Param 			namespace path string true "Namespace"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		202 {object} NamespaceStatusResponse
+// @Failure			400,401,403,404,409,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (ns *Controller) DeleteNamespace(ctx *gin.Context) {
+	name := ctx.Param("namespace")
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	namespaces := ns.client(ctx).CoreV1().Namespaces()
+	if err := namespaces.Delete(ctx.Request.Context(), name, metav1.DeleteOptions{DryRun: dryRun}); err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	namespace, err := namespaces.Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		ctx.JSON(http.StatusAccepted, NamespaceStatusResponse{Name: name, Deleted: true})
+		return
+	}
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusAccepted, newNamespaceStatus(namespace))
+}
+
+// GetNamespaceStatus
+// @Summary			Get namespace status
+// @Description		Return the phase of a namespace and, while it is terminating, the finalizers and remaining resources blocking its removal. Reports deleted=true once it is gone.
+// @Tags			namespace
+// @Router			/api/v1/namespaces/{namespace}/status [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Response		200 {object} NamespaceStatusResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (ns *Controller) GetNamespaceStatus(ctx *gin.Context) {
+	name := ctx.Param("namespace")
+	namespace, err := ns.client(ctx).CoreV1().Namespaces().Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		ctx.JSON(http.StatusOK, NamespaceStatusResponse{Name: name, Deleted: true})
+		return
+	}
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, newNamespaceStatus(namespace))
+}
+
+// ExtendNamespace
+// @Summary			Extend namespace expiry
+// @Description		Push back the expiry of a namespace carrying a TTL or expires-at annotation. The extension is added to the current expiry, or to now when it already passed.
+// @Tags			namespace
+// @Router			/api/v1/namespaces/{namespace}/extend [post]
+// @Param 			namespace path string true "Namespace"
+// @Param 			request body ExtendNamespaceRequest true "Extension"
+// @Response		200 {object} NamespaceExpiryResponse
+// @Failure			400,401,403,404,409,500 {object} common.ErrorResponse
+// @Accept			application/json
+// @Produce			application/json
+func (ns *Controller) ExtendNamespace(ctx *gin.Context) {
+	name := ctx.Param("namespace")
+	var request ExtendNamespaceRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	by, err := parseTTL("by", request.By)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	namespaces := ns.client(ctx).CoreV1().Namespaces()
+	namespace, err := namespaces.Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	expiresAt, ok, err := expiry(namespace)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if !ok {
+		common.BadRequest(ctx, fmt.Errorf("namespace %s has no %s or %s annotation", name, TTLAnnotation, ExpiresAtAnnotation))
+		return
+	}
+	if now := time.Now(); expiresAt.Before(now) {
+		expiresAt = now
+	}
+	expiresAt = expiresAt.Add(by).UTC().Truncate(time.Second)
+	// The resourceVersion turns the patch into a compare-and-swap, so two
+	// concurrent extensions fail with 409 instead of one being lost.
+	data, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"resourceVersion": namespace.ResourceVersion,
+			"annotations": map[string]any{
+				ExpiresAtAnnotation: expiresAt.Format(time.RFC3339),
+				TTLAnnotation:       nil,
+			},
+		},
+	})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	if _, err := namespaces.Patch(ctx.Request.Context(), name, types.MergePatchType, data, metav1.PatchOptions{}); err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, NamespaceExpiryResponse{Name: name, ExpiresAt: metav1.Time{Time: expiresAt}})
+}
+
+// GetNamespaceSummary
+// @Summary			Get namespace summary
+// @Description		Return counts and health of the workloads of a namespace: deployments, pods by phase and waiting reason, services, PVCs, recent warning events and requested resources against ResourceQuotas. Sections that cannot be read are reported in errors.
+// @Tags			namespace
+// @Router			/api/v1/namespaces/{namespace}/summary [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			since query string false "Only include warning events seen within this duration" default(1h)
+// @Param 			consistency query string false "Read from the informer cache (cached) or the apiserver (strong)" Enums(cached, strong) default(cached)
+// @Response		200 {object} NamespaceSummaryResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (ns *Controller) GetNamespaceSummary(ctx *gin.Context) {
+	name := ctx.Param("namespace")
+	since, err := parseTTL("since", ctx.DefaultQuery("since", "1h"))
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	cached, err := cluster.UseCache(ctx, ns.cache(ctx), true)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	var cache *cluster.Cache
+	if cached {
+		cache = ns.cache(ctx)
+	}
+	client := ns.client(ctx)
+	namespace, err := client.CoreV1().Namespaces().Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+
+	summary := NamespaceSummaryResponse{Namespace: name, Phase: namespace.Status.Phase}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	collect := func(section string, fetch func(context.Context) error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fetch(ctx.Request.Context()); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				if summary.Errors == nil {
+					summary.Errors = map[string]string{}
+				}
+				summary.Errors[section] = err.Error()
+			}
+		}()
+	}
+	collect("deployments", func(c context.Context) error {
+		deployments, err := cluster.ListDeployments(c, client, cache, name)
+		summary.Deployments = summarizeDeployments(deployments)
+		return err
+	})
+	collect("pods", func(c context.Context) error {
+		pods, err := cluster.ListPods(c, client, cache, name, labels.Everything())
+		summary.Pods = summarizePods(pods)
+		summary.Resources.Requests = podRequests(pods)
+		return err
+	})
+	collect("services", func(c context.Context) error {
+		services, err := client.CoreV1().Services(name).List(c, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		summary.Services = len(services.Items)
+		return nil
+	})
+	collect("persistentVolumeClaims", func(c context.Context) error {
+		claims, err := client.CoreV1().PersistentVolumeClaims(name).List(c, metav1.ListOptions{})
+		if err != nil {
+			summary.PersistentVolumeClaims = summarizePVCs(nil)
+			return err
+		}
+		summary.PersistentVolumeClaims = summarizePVCs(claims.Items)
+		return nil
+	})
+	collect("warningEvents", func(c context.Context) error {
+		events, err := client.CoreV1().Events(name).List(c, metav1.ListOptions{FieldSelector: "type=" + v1.EventTypeWarning})
+		if err != nil {
+			summary.WarningEvents = []WarningEvent{}
+			return err
+		}
+		summary.WarningEvents = recentWarnings(events.Items, time.Now().Add(-since))
+		return nil
+	})
+	collect("resourceQuotas", func(c context.Context) error {
+		quotas, err := client.CoreV1().ResourceQuotas(name).List(c, metav1.ListOptions{})
+		if err != nil {
+			summary.Resources.Quotas = []QuotaSummary{}
+			return err
+		}
+		summary.Resources.Quotas = summarizeQuotas(quotas.Items)
+		return nil
+	})
+	wg.Wait()
+	ctx.JSON(http.StatusOK, summary)
+}
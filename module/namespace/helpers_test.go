@@ -0,0 +1,19 @@
+package namespace
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newTestNamespace(name string, created time.Time, annotations map[string]string) *v1.Namespace {
+	return &v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:              name,
+		UID:               types.UID("uid-" + name),
+		ResourceVersion:   "1",
+		CreationTimestamp: metav1.NewTime(created),
+		Annotations:       annotations,
+	}}
+}
@@ -0,0 +1,206 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"log"
+	"time"
+)
+
+const (
+	// TTLAnnotation holds a duration, e.g. 72h, after which the namespace expires,
+	// counted from its creation.
+	TTLAnnotation = "go-kubernetes.io/ttl"
+	// ExpiresAtAnnotation holds the RFC 3339 time at which the namespace expires.
+	// It takes precedence over TTLAnnotation.
+	ExpiresAtAnnotation = "go-kubernetes.io/expires-at"
+
+	// ReaperLeaseName is the coordination Lease the reaper replicas elect a leader with.
+	ReaperLeaseName = "go-kubernetes-namespace-reaper"
+	reaperComponent = "go-kubernetes-namespace-reaper"
+)
+
+// protectedNamespaces are never deleted by the reaper, whatever their annotations say.
+var protectedNamespaces = map[string]bool{
+	metav1.NamespaceDefault: true,
+	metav1.NamespaceSystem:  true,
+	metav1.NamespacePublic:  true,
+	v1.NamespaceNodeLease:   true,
+}
+
+// Reaper deletes namespaces whose TTL or expiry annotation has passed. Only the
+// replica holding the Lease acts, so several servers can run it side by side.
+type Reaper struct {
+	Client kubernetes.Interface
+	// Cache is used to list namespaces once synced; otherwise the apiserver is listed.
+	Cache *cluster.Cache
+	// Interval between two passes over the namespaces.
+	Interval time.Duration
+	// LeaseNamespace is the namespace of the Lease used for leader election.
+	LeaseNamespace string
+	// Identity names this replica in the Lease and in the emitted Events.
+	Identity string
+}
+
+// Run elects a leader and reaps expired namespaces while leading, until ctx is done.
+func (r *Reaper) Run(ctx context.Context, clusterName string) {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: r.LeaseNamespace,
+			Name:      ReaperLeaseName,
+		},
+		Client:     r.Client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: r.Identity},
+	}
+	for ctx.Err() == nil {
+		leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			ReleaseOnCancel: true,
+			LeaseDuration:   15 * time.Second,
+			RenewDeadline:   10 * time.Second,
+			RetryPeriod:     2 * time.Second,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					log.Printf("cluster %q: namespace reaper started leading as %s", clusterName, r.Identity)
+					wait.UntilWithContext(ctx, func(ctx context.Context) {
+						r.reap(ctx, clusterName)
+					}, r.Interval)
+				},
+				OnStoppedLeading: func() {
+					log.Printf("cluster %q: namespace reaper stopped leading", clusterName)
+				},
+			},
+		})
+	}
+}
+
+// reap deletes every expired namespace and records an Event for each deletion.
+func (r *Reaper) reap(ctx context.Context, clusterName string) {
+	namespaces, err := r.namespaces(ctx)
+	if err != nil {
+		log.Printf("cluster %q: namespace reaper: listing namespaces: %v", clusterName, err)
+		return
+	}
+	now := time.Now()
+	for _, namespace := range namespaces {
+		if protectedNamespaces[namespace.Name] || namespace.DeletionTimestamp != nil {
+			continue
+		}
+		expiresAt, ok, err := expiry(namespace)
+		if err != nil {
+			log.Printf("cluster %q: namespace reaper: skipping %s: %v", clusterName, namespace.Name, err)
+			continue
+		}
+		if !ok || expiresAt.After(now) {
+			continue
+		}
+		// The listed copy may come from a stale cache: confirm the expiry on
+		// the current object so an extension made since is honoured.
+		current, err := r.Client.CoreV1().Namespaces().Get(ctx, namespace.Name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				log.Printf("cluster %q: namespace reaper: getting %s: %v", clusterName, namespace.Name, err)
+			}
+			continue
+		}
+		namespace = current
+		if namespace.DeletionTimestamp != nil {
+			continue
+		}
+		if expiresAt, ok, err = expiry(namespace); err != nil || !ok || expiresAt.After(now) {
+			continue
+		}
+		// The resourceVersion precondition fails when the namespace changed
+		// after the Get, e.g. because it was extended; the next pass re-checks it.
+		uid, resourceVersion := namespace.UID, namespace.ResourceVersion
+		err = r.Client.CoreV1().Namespaces().Delete(ctx, namespace.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &uid, ResourceVersion: &resourceVersion},
+		})
+		if apierrors.IsConflict(err) {
+			log.Printf("cluster %q: namespace reaper: %s changed while reaping, retrying next pass", clusterName, namespace.Name)
+			continue
+		}
+		if err != nil {
+			log.Printf("cluster %q: namespace reaper: deleting %s: %v", clusterName, namespace.Name, err)
+			continue
+		}
+		message := fmt.Sprintf("Deleted namespace %s, it expired at %s", namespace.Name, expiresAt.UTC().Format(time.RFC3339))
+		log.Printf("cluster %q: namespace reaper: %s", clusterName, message)
+		// The event is informational: failing to record it must not stop the reaping.
+		if err := r.recordEvent(ctx, namespace, message); err != nil {
+			log.Printf("cluster %q: namespace reaper: recording event for %s: %v", clusterName, namespace.Name, err)
+		}
+	}
+}
+
+func (r *Reaper) namespaces(ctx context.Context) ([]*v1.Namespace, error) {
+	if r.Cache.Synced() {
+		return r.Cache.Namespaces().List(labels.Everything())
+	}
+	list, err := r.Client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make([]*v1.Namespace, 0, len(list.Items))
+	for i := range list.Items {
+		namespaces = append(namespaces, &list.Items[i])
+	}
+	return namespaces, nil
+}
+
+// recordEvent emits a Normal Expired event on the namespace. As for every
+// cluster scoped object, the event is stored in the default namespace.
+func (r *Reaper) recordEvent(ctx context.Context, namespace *v1.Namespace, message string) error {
+	now := metav1.Now()
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: namespace.Name + ".",
+			Namespace:    metav1.NamespaceDefault,
+		},
+		InvolvedObject: v1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Namespace",
+			Name:       namespace.Name,
+			UID:        namespace.UID,
+		},
+		Reason:              "Expired",
+		Message:             message,
+		Type:                v1.EventTypeNormal,
+		Source:              v1.EventSource{Component: reaperComponent},
+		FirstTimestamp:      now,
+		LastTimestamp:       now,
+		Count:               1,
+		ReportingController: reaperComponent,
+		ReportingInstance:   r.Identity,
+	}
+	_, err := r.Client.CoreV1().Events(metav1.NamespaceDefault).Create(ctx, event, metav1.CreateOptions{})
+	return err
+}
+
+// expiry returns when a namespace expires and whether it carries an expiry at all.
+func expiry(namespace *v1.Namespace) (time.Time, bool, error) {
+	if value, ok := namespace.Annotations[ExpiresAtAnnotation]; ok {
+		expiresAt, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %s annotation: %w", ExpiresAtAnnotation, err)
+		}
+		return expiresAt, true, nil
+	}
+	if value, ok := namespace.Annotations[TTLAnnotation]; ok {
+		ttl, err := time.ParseDuration(value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %s annotation: %w", TTLAnnotation, err)
+		}
+		return namespace.CreationTimestamp.Add(ttl), true, nil
+	}
+	return time.Time{}, false, nil
+}
@@ -0,0 +1,216 @@
+package namespace
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestExpiry(t *testing.T) {
+	created := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        time.Time
+		wantOK      bool
+		wantErr     string
+	}{
+		{
+			name: "no annotation",
+		},
+		{
+			name:        "expires-at",
+			annotations: map[string]string{ExpiresAtAnnotation: "2023-10-04T12:00:00Z"},
+			want:        time.Date(2023, 10, 4, 12, 0, 0, 0, time.UTC),
+			wantOK:      true,
+		},
+		{
+			name:        "ttl counted from creation",
+			annotations: map[string]string{TTLAnnotation: "72h"},
+			want:        created.Add(72 * time.Hour),
+			wantOK:      true,
+		},
+		{
+			name:        "expires-at takes precedence",
+			annotations: map[string]string{TTLAnnotation: "1h", ExpiresAtAnnotation: "2023-10-04T12:00:00Z"},
+			want:        time.Date(2023, 10, 4, 12, 0, 0, 0, time.UTC),
+			wantOK:      true,
+		},
+		{
+			name:        "invalid expires-at",
+			annotations: map[string]string{ExpiresAtAnnotation: "tomorrow"},
+			wantErr:     "invalid " + ExpiresAtAnnotation,
+		},
+		{
+			name:        "invalid ttl",
+			annotations: map[string]string{TTLAnnotation: "3 days"},
+			wantErr:     "invalid " + TTLAnnotation,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expiresAt, ok, err := expiry(newTestNamespace("preview", created, test.annotations))
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ok != test.wantOK || !expiresAt.Equal(test.want) {
+				t.Errorf("got %s, %v, want %s, %v", expiresAt, ok, test.want, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr string
+	}{
+		{value: "72h", want: 72 * time.Hour},
+		{value: "90m", want: 90 * time.Minute},
+		{value: "1h30m", want: 90 * time.Minute},
+		{value: "0s", wantErr: "ttl must be positive"},
+		{value: "-1h", wantErr: "ttl must be positive"},
+		{value: "3d", wantErr: "invalid ttl"},
+		{value: "", wantErr: "invalid ttl"},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			ttl, err := parseTTL("ttl", test.value)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ttl != test.want {
+				t.Errorf("got %s, want %s", ttl, test.want)
+			}
+		})
+	}
+}
+
+func TestReap(t *testing.T) {
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	created := time.Now().Add(-48 * time.Hour)
+	tests := []struct {
+		name string
+		// current is the namespace stored by the apiserver.
+		current *v1.Namespace
+		// listed, when set, is the possibly stale copy returned by the list.
+		listed      *v1.Namespace
+		deleteErr   error
+		eventErr    error
+		wantDeleted bool
+	}{
+		{
+			name:        "expired",
+			current:     newTestNamespace("preview", created, map[string]string{ExpiresAtAnnotation: past}),
+			wantDeleted: true,
+		},
+		{
+			name:        "expired ttl",
+			current:     newTestNamespace("preview", created, map[string]string{TTLAnnotation: "24h"}),
+			wantDeleted: true,
+		},
+		{
+			name:    "not expired",
+			current: newTestNamespace("preview", created, map[string]string{ExpiresAtAnnotation: future}),
+		},
+		{
+			name:    "without annotation",
+			current: newTestNamespace("preview", created, nil),
+		},
+		{
+			name:    "protected",
+			current: newTestNamespace(metav1.NamespaceDefault, created, map[string]string{ExpiresAtAnnotation: past}),
+		},
+		{
+			name:    "extended since listed",
+			current: newTestNamespace("preview", created, map[string]string{ExpiresAtAnnotation: future}),
+			listed:  newTestNamespace("preview", created, map[string]string{ExpiresAtAnnotation: past}),
+		},
+		{
+			name:        "event not recorded",
+			current:     newTestNamespace("preview", created, map[string]string{ExpiresAtAnnotation: past}),
+			eventErr:    apierrors.NewForbidden(v1.Resource("events"), "", errors.New("denied")),
+			wantDeleted: true,
+		},
+		{
+			name:      "changed before the delete",
+			current:   newTestNamespace("preview", created, map[string]string{ExpiresAtAnnotation: past}),
+			deleteErr: apierrors.NewConflict(v1.Resource("namespaces"), "preview", errors.New("resourceVersion mismatch")),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(test.current)
+			if test.listed != nil {
+				client.PrependReactor("list", "namespaces", func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, &v1.NamespaceList{Items: []v1.Namespace{*test.listed}}, nil
+				})
+			}
+			if test.deleteErr != nil {
+				client.PrependReactor("delete", "namespaces", func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, test.deleteErr
+				})
+			}
+			if test.eventErr != nil {
+				client.PrependReactor("create", "events", func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, test.eventErr
+				})
+			}
+			reaper := &Reaper{Client: client}
+			reaper.reap(context.Background(), "test")
+
+			_, err := client.CoreV1().Namespaces().Get(context.Background(), test.current.Name, metav1.GetOptions{})
+			if deleted := apierrors.IsNotFound(err); deleted != test.wantDeleted {
+				t.Errorf("got deleted %v, want %v", deleted, test.wantDeleted)
+			}
+			for _, action := range client.Actions() {
+				deleteAction, ok := action.(k8stesting.DeleteAction)
+				if !ok {
+					continue
+				}
+				preconditions := deleteAction.GetDeleteOptions().Preconditions
+				if preconditions == nil || preconditions.UID == nil || *preconditions.UID != test.current.UID ||
+					preconditions.ResourceVersion == nil || *preconditions.ResourceVersion != test.current.ResourceVersion {
+					t.Errorf("delete without UID and resourceVersion preconditions: %+v", preconditions)
+				}
+			}
+			events, err := client.CoreV1().Events(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The event is only recorded once the namespace is gone.
+			wantEvent := test.wantDeleted && test.eventErr == nil
+			if gotEvent := len(events.Items) > 0; gotEvent != wantEvent {
+				t.Errorf("got event %v, want %v", gotEvent, wantEvent)
+			}
+			for _, event := range events.Items {
+				if !strings.HasPrefix(event.Message, "Deleted namespace preview") {
+					t.Errorf("got event message %q", event.Message)
+				}
+			}
+		})
+	}
+}
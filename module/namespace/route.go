@@ -12,4 +12,12 @@ func NewNamespaceRoute(controller Controller) Route {
 
 func (r *Route) Route(router *gin.RouterGroup) {
 	router.GET("", r.controller.ListNamespace)
+	router.POST("", r.controller.CreateNamespace)
+	router.GET(":namespace", r.controller.GetNamespace)
+	router.PATCH(":namespace", r.controller.PatchNamespace)
+	router.DELETE(":namespace", r.controller.DeleteNamespace)
+	router.GET(":namespace/status", r.controller.GetNamespaceStatus)
+	router.PATCH(":namespace/metadata", r.controller.UpdateNamespaceMetadata)
+	router.POST(":namespace/extend", r.controller.ExtendNamespace)
+	router.GET(":namespace/summary", r.controller.GetNamespaceSummary)
 }
@@ -0,0 +1,131 @@
+package namespace
+
+import (
+	"fmt"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type CreateNamespaceRequest struct {
+	Name        string            `json:"name" binding:"required"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	// TTL, e.g. 72h, after which the reaper deletes the namespace.
+	TTL string `json:"ttl"`
+}
+
+type ExtendNamespaceRequest struct {
+	// By is added to the current expiry, or to now when it already passed, e.g. 24h.
+	By string `json:"by" binding:"required"`
+}
+
+type NamespaceExpiryResponse struct {
+	Name      string      `json:"name"`
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// UpdateMetadataRequest sets labels and annotations; a null value removes the key.
+type UpdateMetadataRequest struct {
+	Labels      map[string]*string `json:"labels"`
+	Annotations map[string]*string `json:"annotations"`
+}
+
+// RemainingResource is a resource type still present in a terminating namespace.
+type RemainingResource struct {
+	Resource  string `json:"resource"`
+	Instances int    `json:"instances"`
+}
+
+// RemainingFinalizer is a finalizer blocking the removal of content in a terminating namespace.
+type RemainingFinalizer struct {
+	Finalizer string `json:"finalizer"`
+	Instances int    `json:"instances"`
+}
+
+// NamespaceStatusResponse reports the lifecycle of a namespace, including what
+// blocks the deletion of a terminating one.
+type NamespaceStatusResponse struct {
+	Name                string                  `json:"name"`
+	Phase               v1.NamespacePhase       `json:"phase"`
+	Deleted             bool                    `json:"deleted"`
+	ExpiresAt           *metav1.Time            `json:"expiresAt,omitempty"`
+	Finalizers          []string                `json:"finalizers,omitempty"`
+	RemainingResources  []RemainingResource     `json:"remainingResources,omitempty"`
+	RemainingFinalizers []RemainingFinalizer    `json:"remainingFinalizers,omitempty"`
+	Conditions          []v1.NamespaceCondition `json:"conditions,omitempty"`
+}
+
+// newNamespaceStatus summarizes the phase, finalizers and deletion conditions of a namespace.
+func newNamespaceStatus(namespace *v1.Namespace) NamespaceStatusResponse {
+	status := NamespaceStatusResponse{
+		Name:  namespace.Name,
+		Phase: namespace.Status.Phase,
+	}
+	for _, finalizer := range namespace.Spec.Finalizers {
+		status.Finalizers = append(status.Finalizers, string(finalizer))
+	}
+	status.Finalizers = append(status.Finalizers, namespace.Finalizers...)
+	if expiresAt, ok, err := expiry(namespace); ok && err == nil {
+		status.ExpiresAt = &metav1.Time{Time: expiresAt}
+	}
+	for _, condition := range namespace.Status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		status.Conditions = append(status.Conditions, condition)
+		switch condition.Type {
+		case v1.NamespaceContentRemaining:
+			for _, item := range conditionItems(condition.Message, " has ") {
+				status.RemainingResources = append(status.RemainingResources, RemainingResource{Resource: item.name, Instances: item.instances})
+			}
+		case v1.NamespaceFinalizersRemaining:
+			for _, item := range conditionItems(condition.Message, " in ") {
+				status.RemainingFinalizers = append(status.RemainingFinalizers, RemainingFinalizer{Finalizer: item.name, Instances: item.instances})
+			}
+		}
+	}
+	return status
+}
+
+type conditionItem struct {
+	name      string
+	instances int
+}
+
+// conditionItems parses the messages the namespace controller reports, e.g.
+// "Some resources are remaining: pods. has 2 resource instances, deployments.apps has 1 resource instances".
+func conditionItems(message, separator string) []conditionItem {
+	_, list, found := strings.Cut(message, ": ")
+	if !found {
+		return nil
+	}
+	var items []conditionItem
+	for _, entry := range strings.Split(list, ", ") {
+		name, rest, found := strings.Cut(entry, separator)
+		if !found {
+			continue
+		}
+		count, _, _ := strings.Cut(rest, " ")
+		instances, err := strconv.Atoi(count)
+		if err != nil {
+			continue
+		}
+		items = append(items, conditionItem{name: strings.TrimSuffix(name, "."), instances: instances})
+	}
+	return items
+}
+
+// parseTTL parses a TTL or extension, which must be a positive duration.
+func parseTTL(name, value string) (time.Duration, error) {
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("%s must be positive, got %s", name, value)
+	}
+	return ttl, nil
+}
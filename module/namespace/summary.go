@@ -0,0 +1,194 @@
+package namespace
+
+import (
+	"github.com/jobayer12/go-kubernetes/module/event"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sort"
+	"time"
+)
+
+// maxSummaryEvents bounds the number of warning events returned in a summary.
+const maxSummaryEvents = 20
+
+// NamespaceSummaryResponse aggregates the workloads and health of a namespace.
+// Sections that could not be read are reported in Errors and left empty.
+type NamespaceSummaryResponse struct {
+	Namespace              string            `json:"namespace"`
+	Phase                  v1.NamespacePhase `json:"phase"`
+	Deployments            DeploymentSummary `json:"deployments"`
+	Pods                   PodSummary        `json:"pods"`
+	Services               int               `json:"services"`
+	PersistentVolumeClaims PVCSummary        `json:"persistentVolumeClaims"`
+	WarningEvents          []WarningEvent    `json:"warningEvents"`
+	Resources              ResourceSummary   `json:"resources"`
+	Errors                 map[string]string `json:"errors,omitempty"`
+}
+
+type DeploymentSummary struct {
+	Total           int   `json:"total"`
+	Ready           int   `json:"ready"`
+	DesiredReplicas int32 `json:"desiredReplicas"`
+	ReadyReplicas   int32 `json:"readyReplicas"`
+	// NotReady lists the deployments with fewer ready replicas than desired.
+	NotReady []DeploymentHealth `json:"notReady"`
+}
+
+type DeploymentHealth struct {
+	Name            string `json:"name"`
+	DesiredReplicas int32  `json:"desiredReplicas"`
+	ReadyReplicas   int32  `json:"readyReplicas"`
+}
+
+type PodSummary struct {
+	Total           int            `json:"total"`
+	ByPhase         map[string]int `json:"byPhase"`
+	ByWaitingReason map[string]int `json:"byWaitingReason"`
+}
+
+type PVCSummary struct {
+	Total   int            `json:"total"`
+	ByPhase map[string]int `json:"byPhase"`
+}
+
+type WarningEvent struct {
+	Kind     string      `json:"kind"`
+	Name     string      `json:"name"`
+	Reason   string      `json:"reason"`
+	Message  string      `json:"message"`
+	Count    int32       `json:"count"`
+	LastSeen metav1.Time `json:"lastSeen"`
+}
+
+// ResourceSummary compares the resources requested by running pods with the
+// ResourceQuotas of the namespace.
+type ResourceSummary struct {
+	Requests v1.ResourceList `json:"requests"`
+	Quotas   []QuotaSummary  `json:"quotas"`
+}
+
+type QuotaSummary struct {
+	Name string          `json:"name"`
+	Hard v1.ResourceList `json:"hard"`
+	Used v1.ResourceList `json:"used"`
+}
+
+func summarizeDeployments(deployments []*appsv1.Deployment) DeploymentSummary {
+	summary := DeploymentSummary{Total: len(deployments), NotReady: []DeploymentHealth{}}
+	for _, deployment := range deployments {
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		ready := deployment.Status.ReadyReplicas
+		summary.DesiredReplicas += desired
+		summary.ReadyReplicas += ready
+		if ready >= desired {
+			summary.Ready++
+			continue
+		}
+		summary.NotReady = append(summary.NotReady, DeploymentHealth{
+			Name:            deployment.Name,
+			DesiredReplicas: desired,
+			ReadyReplicas:   ready,
+		})
+	}
+	sort.Slice(summary.NotReady, func(i, j int) bool {
+		return summary.NotReady[i].Name < summary.NotReady[j].Name
+	})
+	return summary
+}
+
+func summarizePods(pods []*v1.Pod) PodSummary {
+	summary := PodSummary{Total: len(pods), ByPhase: map[string]int{}, ByWaitingReason: map[string]int{}}
+	for _, pod := range pods {
+		summary.ByPhase[string(pod.Status.Phase)]++
+		for _, statuses := range [][]v1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+			for _, status := range statuses {
+				if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+					summary.ByWaitingReason[status.State.Waiting.Reason]++
+				}
+			}
+		}
+	}
+	return summary
+}
+
+func summarizePVCs(claims []v1.PersistentVolumeClaim) PVCSummary {
+	summary := PVCSummary{Total: len(claims), ByPhase: map[string]int{}}
+	for _, claim := range claims {
+		summary.ByPhase[string(claim.Status.Phase)]++
+	}
+	return summary
+}
+
+// recentWarnings returns the newest warning events seen after since.
+func recentWarnings(events []v1.Event, since time.Time) []WarningEvent {
+	warnings := []WarningEvent{}
+	for _, item := range events {
+		if item.Type != v1.EventTypeWarning {
+			continue
+		}
+		lastSeen := event.LastSeen(item)
+		if lastSeen.Before(since) {
+			continue
+		}
+		warnings = append(warnings, WarningEvent{
+			Kind:     item.InvolvedObject.Kind,
+			Name:     item.InvolvedObject.Name,
+			Reason:   item.Reason,
+			Message:  item.Message,
+			Count:    item.Count,
+			LastSeen: metav1.Time{Time: lastSeen},
+		})
+	}
+	sort.Slice(warnings, func(i, j int) bool {
+		return warnings[i].LastSeen.After(warnings[j].LastSeen.Time)
+	})
+	if len(warnings) > maxSummaryEvents {
+		warnings = warnings[:maxSummaryEvents]
+	}
+	return warnings
+}
+
+// podRequests sums the CPU and memory requested by pods that are not finished.
+// A pod requests the larger of its containers' sum and its biggest init container.
+func podRequests(pods []*v1.Pod) v1.ResourceList {
+	total := v1.ResourceList{
+		v1.ResourceCPU:    resource.Quantity{},
+		v1.ResourceMemory: resource.Quantity{},
+	}
+	for _, pod := range pods {
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		for name, quantity := range total {
+			var containers resource.Quantity
+			for _, container := range pod.Spec.Containers {
+				containers.Add(container.Resources.Requests[name])
+			}
+			for _, container := range pod.Spec.InitContainers {
+				if request := container.Resources.Requests[name]; request.Cmp(containers) > 0 {
+					containers = request.DeepCopy()
+				}
+			}
+			quantity.Add(containers)
+			total[name] = quantity
+		}
+	}
+	return total
+}
+
+func summarizeQuotas(quotas []v1.ResourceQuota) []QuotaSummary {
+	summaries := make([]QuotaSummary, 0, len(quotas))
+	for _, quota := range quotas {
+		summaries = append(summaries, QuotaSummary{
+			Name: quota.Name,
+			Hard: quota.Status.Hard,
+			Used: quota.Status.Used,
+		})
+	}
+	return summaries
+}
@@ -0,0 +1,293 @@
+package namespace
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+func newTestSummaryDeployment(name string, replicas *int32, ready int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "preview"},
+		Spec:       appsv1.DeploymentSpec{Replicas: replicas},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: ready},
+	}
+}
+
+func TestSummarizeDeployments(t *testing.T) {
+	tests := []struct {
+		name        string
+		deployments []*appsv1.Deployment
+		want        DeploymentSummary
+	}{
+		{
+			name: "none",
+			want: DeploymentSummary{NotReady: []DeploymentHealth{}},
+		},
+		{
+			name: "ready and not ready sorted by name",
+			deployments: []*appsv1.Deployment{
+				newTestSummaryDeployment("web", ptr.To[int32](3), 3),
+				newTestSummaryDeployment("worker", ptr.To[int32](2), 0),
+				newTestSummaryDeployment("api", ptr.To[int32](2), 1),
+			},
+			want: DeploymentSummary{
+				Total:           3,
+				Ready:           1,
+				DesiredReplicas: 7,
+				ReadyReplicas:   4,
+				NotReady: []DeploymentHealth{
+					{Name: "api", DesiredReplicas: 2, ReadyReplicas: 1},
+					{Name: "worker", DesiredReplicas: 2, ReadyReplicas: 0},
+				},
+			},
+		},
+		{
+			name:        "replicas default to one",
+			deployments: []*appsv1.Deployment{newTestSummaryDeployment("web", nil, 0)},
+			want: DeploymentSummary{
+				Total:           1,
+				DesiredReplicas: 1,
+				NotReady:        []DeploymentHealth{{Name: "web", DesiredReplicas: 1}},
+			},
+		},
+		{
+			name:        "scaled to zero",
+			deployments: []*appsv1.Deployment{newTestSummaryDeployment("web", ptr.To[int32](0), 0)},
+			want:        DeploymentSummary{Total: 1, Ready: 1, NotReady: []DeploymentHealth{}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := summarizeDeployments(test.deployments); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRecentWarnings(t *testing.T) {
+	now := time.Now()
+	newEvent := func(reason, eventType string, age time.Duration) v1.Event {
+		return v1.Event{
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "web"},
+			Type:           eventType,
+			Reason:         reason,
+			LastTimestamp:  metav1.NewTime(now.Add(-age)),
+		}
+	}
+	tests := []struct {
+		name   string
+		events []v1.Event
+		want   []string
+	}{
+		{
+			name: "none",
+			want: []string{},
+		},
+		{
+			name: "newest first within the window",
+			events: []v1.Event{
+				newEvent("FailedMount", v1.EventTypeWarning, 30*time.Minute),
+				newEvent("BackOff", v1.EventTypeWarning, time.Minute),
+				newEvent("Pulled", v1.EventTypeNormal, time.Minute),
+				newEvent("FailedScheduling", v1.EventTypeWarning, 2*time.Hour),
+			},
+			want: []string{"BackOff", "FailedMount"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reasons := []string{}
+			for _, warning := range recentWarnings(test.events, now.Add(-time.Hour)) {
+				reasons = append(reasons, warning.Reason)
+			}
+			if !reflect.DeepEqual(reasons, test.want) {
+				t.Errorf("got %v, want %v", reasons, test.want)
+			}
+		})
+	}
+}
+
+func TestRecentWarningsLimit(t *testing.T) {
+	now := time.Now()
+	var events []v1.Event
+	for i := 0; i < maxSummaryEvents+5; i++ {
+		events = append(events, v1.Event{Type: v1.EventTypeWarning, LastTimestamp: metav1.NewTime(now.Add(-time.Duration(i) * time.Second))})
+	}
+	warnings := recentWarnings(events, now.Add(-time.Hour))
+	if len(warnings) != maxSummaryEvents {
+		t.Fatalf("got %d warnings, want %d", len(warnings), maxSummaryEvents)
+	}
+	if !warnings[0].LastSeen.Equal(&events[0].LastTimestamp) {
+		t.Errorf("the newest warning was dropped")
+	}
+}
+
+func TestPodRequests(t *testing.T) {
+	container := func(cpu, memory string) v1.Container {
+		return v1.Container{Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(cpu),
+			v1.ResourceMemory: resource.MustParse(memory),
+		}}}
+	}
+	newPod := func(phase v1.PodPhase, init []v1.Container, containers ...v1.Container) *v1.Pod {
+		return &v1.Pod{
+			Spec:   v1.PodSpec{InitContainers: init, Containers: containers},
+			Status: v1.PodStatus{Phase: phase},
+		}
+	}
+	tests := []struct {
+		name       string
+		pods       []*v1.Pod
+		wantCPU    string
+		wantMemory string
+	}{
+		{
+			name:       "none",
+			wantCPU:    "0",
+			wantMemory: "0",
+		},
+		{
+			name:       "containers are summed",
+			pods:       []*v1.Pod{newPod(v1.PodRunning, nil, container("100m", "64Mi"), container("200m", "128Mi"))},
+			wantCPU:    "300m",
+			wantMemory: "192Mi",
+		},
+		{
+			name:       "a larger init container wins",
+			pods:       []*v1.Pod{newPod(v1.PodPending, []v1.Container{container("500m", "32Mi")}, container("100m", "64Mi"), container("200m", "128Mi"))},
+			wantCPU:    "500m",
+			wantMemory: "192Mi",
+		},
+		{
+			name: "finished pods are skipped",
+			pods: []*v1.Pod{
+				newPod(v1.PodRunning, nil, container("100m", "64Mi")),
+				newPod(v1.PodSucceeded, nil, container("1", "1Gi")),
+				newPod(v1.PodFailed, nil, container("1", "1Gi")),
+			},
+			wantCPU:    "100m",
+			wantMemory: "64Mi",
+		},
+		{
+			name:       "containers without requests",
+			pods:       []*v1.Pod{newPod(v1.PodRunning, nil, v1.Container{}, container("100m", "64Mi"))},
+			wantCPU:    "100m",
+			wantMemory: "64Mi",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := podRequests(test.pods)
+			for name, want := range map[v1.ResourceName]string{v1.ResourceCPU: test.wantCPU, v1.ResourceMemory: test.wantMemory} {
+				got := requests[name]
+				if wantQuantity := resource.MustParse(want); got.Cmp(wantQuantity) != 0 {
+					t.Errorf("got %s %s, want %s", name, got.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetNamespaceSummary(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name       string
+		failing    []string
+		wantErrors []string
+	}{
+		{
+			name: "all sections",
+		},
+		{
+			name:       "sections that cannot be read",
+			failing:    []string{"services", "resourcequotas"},
+			wantErrors: []string{"resourceQuotas", "services"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(
+				newTestNamespace("preview", time.Now(), nil),
+				newTestSummaryDeployment("web", ptr.To[int32](2), 1),
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "preview"}, Status: v1.PodStatus{Phase: v1.PodRunning}},
+				&v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "preview"}},
+				&v1.Event{
+					ObjectMeta:    metav1.ObjectMeta{Name: "web-1.1", Namespace: "preview"},
+					Type:          v1.EventTypeWarning,
+					Reason:        "BackOff",
+					LastTimestamp: metav1.Now(),
+				},
+			)
+			for _, failing := range test.failing {
+				err := apierrors.NewForbidden(v1.Resource(failing), "", errors.New("denied"))
+				client.PrependReactor("list", failing, func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, err
+				})
+			}
+			route := NewNamespaceRoute(NewNamespaceController(&K8sClient{Client: client}))
+			router := gin.New()
+			route.Route(router.Group("/namespaces"))
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/namespaces/preview/summary", nil))
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("got status %d: %s", recorder.Code, recorder.Body.String())
+			}
+			var summary NamespaceSummaryResponse
+			if err := json.Unmarshal(recorder.Body.Bytes(), &summary); err != nil {
+				t.Fatal(err)
+			}
+			var sections []string
+			for section := range summary.Errors {
+				sections = append(sections, section)
+			}
+			sort.Strings(sections)
+			if !reflect.DeepEqual(sections, test.wantErrors) {
+				t.Errorf("got errors %v, want %v", summary.Errors, test.wantErrors)
+			}
+			if summary.Deployments.Total != 1 || summary.Deployments.Ready != 0 || summary.Pods.ByPhase["Running"] != 1 {
+				t.Errorf("got deployments %+v and pods %+v", summary.Deployments, summary.Pods)
+			}
+			if len(summary.WarningEvents) != 1 || summary.WarningEvents[0].Reason != "BackOff" {
+				t.Errorf("got warning events %+v", summary.WarningEvents)
+			}
+			if test.wantErrors == nil && summary.Services != 1 {
+				t.Errorf("got %d services, want 1", summary.Services)
+			}
+			if summary.Resources.Quotas == nil {
+				t.Error("quotas are null instead of empty")
+			}
+		})
+	}
+}
+
+func TestGetNamespaceSummaryNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	route := NewNamespaceRoute(NewNamespaceController(&K8sClient{Client: fake.NewSimpleClientset()}))
+	router := gin.New()
+	route.Route(router.Group("/namespaces"))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/namespaces/preview/summary", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("got status %d, want %d", recorder.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,48 @@
+package pod
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"sort"
+)
+
+// newPodList builds a list response from cached pods, ordered by name.
+func newPodList(pods []*v1.Pod, resourceVersion string) *v1.PodList {
+	list := &v1.PodList{Items: make([]v1.Pod, 0, len(pods))}
+	list.ResourceVersion = resourceVersion
+	for _, pod := range pods {
+		list.Items = append(list.Items, *pod)
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		if list.Items[i].Namespace != list.Items[j].Namespace {
+			return list.Items[i].Namespace < list.Items[j].Namespace
+		}
+		return list.Items[i].Name < list.Items[j].Name
+	})
+	return list
+}
+
+// filterByWaitingReason keeps the pods with a container or init container
+// waiting for reason. An empty reason keeps every pod.
+func filterByWaitingReason(list *v1.PodList, reason string) *v1.PodList {
+	if reason == "" {
+		return list
+	}
+	items := list.Items[:0:0]
+	for _, pod := range list.Items {
+		if hasWaitingReason(pod.Status.InitContainerStatuses, reason) || hasWaitingReason(pod.Status.ContainerStatuses, reason) {
+			items = append(items, pod)
+		}
+	}
+	filtered := *list
+	filtered.Items = items
+	return &filtered
+}
+
+func hasWaitingReason(statuses []v1.ContainerStatus, reason string) bool {
+	for _, status := range statuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package pod
+
+import (
+	"context"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"sort"
+)
+
+// Consumer is a workload whose pod spec references an object.
+type Consumer struct {
+	Name string `json:"name"`
+	// Owner is the kind and name of the controller of a pod, e.g. ReplicaSet/web-5d4f.
+	Owner string `json:"owner,omitempty"`
+	// UsedBy lists the volumes, containers and fields holding the reference.
+	UsedBy []string `json:"usedBy"`
+}
+
+// ConsumersResponse lists the deployments and pods that would pick up a change
+// to a ConfigMap or Secret after a restart.
+type ConsumersResponse struct {
+	Kind        string     `json:"kind"`
+	Name        string     `json:"name"`
+	Namespace   string     `json:"namespace"`
+	Deployments []Consumer `json:"deployments"`
+	Pods        []Consumer `json:"pods"`
+}
+
+// FindConsumers returns the deployments and pods of namespace whose pod spec
+// references the object of the given kind and name. Deployments and pods are
+// read from cache once it has synced.
+func FindConsumers(ctx context.Context, client kubernetes.Interface, cache *cluster.Cache, namespace, kind, name string) (ConsumersResponse, error) {
+	response := ConsumersResponse{Kind: kind, Name: name, Namespace: namespace, Deployments: []Consumer{}, Pods: []Consumer{}}
+	deployments, err := cluster.ListDeployments(ctx, client, cache, namespace)
+	if err != nil {
+		return response, err
+	}
+	for _, deployment := range deployments {
+		if usedBy := referencedBy(&deployment.Spec.Template.Spec, kind, name); len(usedBy) > 0 {
+			response.Deployments = append(response.Deployments, Consumer{Name: deployment.Name, UsedBy: usedBy})
+		}
+	}
+	pods, err := cluster.ListPods(ctx, client, cache, namespace, labels.Everything())
+	if err != nil {
+		return response, err
+	}
+	for _, pod := range pods {
+		if usedBy := referencedBy(&pod.Spec, kind, name); len(usedBy) > 0 {
+			consumer := Consumer{Name: pod.Name, UsedBy: usedBy}
+			if owner := metav1.GetControllerOf(pod); owner != nil {
+				consumer.Owner = owner.Kind + "/" + owner.Name
+			}
+			response.Pods = append(response.Pods, consumer)
+		}
+	}
+	sort.Slice(response.Deployments, func(i, j int) bool { return response.Deployments[i].Name < response.Deployments[j].Name })
+	sort.Slice(response.Pods, func(i, j int) bool { return response.Pods[i].Name < response.Pods[j].Name })
+	return response, nil
+}
+
+func referencedBy(spec *v1.PodSpec, kind, name string) []string {
+	var usedBy []string
+	for _, ref := range SpecReferences(spec) {
+		if ref.Kind == kind && ref.Name == name {
+			usedBy = append(usedBy, ref.UsedBy)
+		}
+	}
+	return usedBy
+}
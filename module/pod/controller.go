@@ -2,11 +2,23 @@ package pod
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	"github.com/jobayer12/go-kubernetes/module/common"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type ListPodResponse struct {
@@ -19,6 +31,8 @@ type GetPodResponse struct {
 
 type K8sClient struct {
 	Client kubernetes.Interface
+	Config *rest.Config
+	Cache  *cluster.Cache
 }
 
 type Controller struct {
@@ -29,22 +43,112 @@ func NewPodController(k8sClient *K8sClient) Controller {
 	return Controller{K8sClient: k8sClient}
 }
 
+// client returns the clientset of the cluster the request targets.
+func (k *K8sClient) client(ctx *gin.Context) kubernetes.Interface {
+	return cluster.Client(ctx, k.Client)
+}
+
+// cache returns the read cache of the cluster the request targets.
+func (k *K8sClient) cache(ctx *gin.Context) *cluster.Cache {
+	return cluster.CacheFor(ctx, k.Cache)
+}
+
+// restConfig returns the client configuration of the cluster the request targets.
+func (k *K8sClient) restConfig(ctx *gin.Context) *rest.Config {
+	return cluster.RestConfig(ctx, k.Config)
+}
+
 // ListPod
 // @Summary			Get the List of Pod.
 // @Description		Return list of Pod.
 // @Tags			pod
 // @Router			/api/v1/namespaces/{namespace}/pods [get]
-// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			namespace path string true "Namespace, _all lists every namespace" default(default)
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. status.phase=Running"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Param 			consistency query string false "Read from the informer cache (cached) or the apiserver (strong)" Enums(cached, strong) default(cached)
+// @Param 			waitingReason query string false "Only return pods with a container waiting for this reason, e.g. CrashLoopBackOff; cannot be combined with limit"
 // @Response		200 {array} ListPodResponse
-// @Produce			application/json
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
 func (p *Controller) ListPod(ctx *gin.Context) {
-	namespace := ctx.Param("namespace")
-	pods, err := p.Client.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{})
+	p.listPods(ctx, common.NamespaceParam(ctx))
+}
+
+// ListAllPod
+// @Summary			Get the List of Pod in all namespaces.
+// @Description		Return list of Pod across every namespace.
+// @Tags			pod
+// @Router			/api/v1/pods [get]
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. status.phase=Running"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Param 			consistency query string false "Read from the informer cache (cached) or the apiserver (strong)" Enums(cached, strong) default(cached)
+// @Param 			waitingReason query string false "Only return pods with a container waiting for this reason, e.g. CrashLoopBackOff; cannot be combined with limit"
+// @Response		200 {array} ListPodResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (p *Controller) ListAllPod(ctx *gin.Context) {
+	p.listPods(ctx, metav1.NamespaceAll)
+}
+
+func (p *Controller) listPods(ctx *gin.Context, namespace string) {
+	query, err := common.ParseListQuery(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, err)
+		common.BadRequest(ctx, err)
 		return
 	}
-	ctx.JSON(http.StatusOK, pods)
+	waitingReason := ctx.Query("waitingReason")
+	// The reason is filtered here, after the apiserver paginated, so a page
+	// could come back short or empty while more pods remain.
+	if waitingReason != "" && query.Options.Limit != 0 {
+		common.BadRequest(ctx, fmt.Errorf("waitingReason is not supported with limit"))
+		return
+	}
+	watch, err := common.WantsWatch(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if watch {
+		if waitingReason != "" {
+			common.BadRequest(ctx, fmt.Errorf("waitingReason is not supported with watch=true"))
+			return
+		}
+		pods := p.client(ctx).CoreV1().Pods(namespace)
+		common.StreamWatch(ctx, common.ListWatch{
+			List: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				return pods.List(ctx, options)
+			},
+			Watch: pods.Watch,
+		}, query.WatchOptions())
+		return
+	}
+	cached, err := cluster.UseCache(ctx, p.cache(ctx), query.Cacheable())
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if cached {
+		items, err := p.cache(ctx).Pods().Pods(namespace).List(query.Labels)
+		if err != nil {
+			common.Error(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, filterByWaitingReason(newPodList(items, p.cache(ctx).PodsResourceVersion()), waitingReason))
+		return
+	}
+	pods, err := p.client(ctx).CoreV1().Pods(namespace).List(ctx.Request.Context(), query.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, filterByWaitingReason(pods, waitingReason))
 }
 
 // GetPod
@@ -54,15 +158,423 @@ func (p *Controller) ListPod(ctx *gin.Context) {
 // @Router			/api/v1/namespaces/{namespace}/pods/{podName} [get]
 // @Param 			namespace path string true "Namespace" default(default)
 // @Param 			podName path string true "Pod name"
+// @Param 			consistency query string false "Read from the informer cache (cached) or the apiserver (strong)" Enums(cached, strong) default(cached)
 // @Response		200 {object} GetPodResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
 // @Produce			application/json
 func (p *Controller) GetPod(ctx *gin.Context) {
 	namespace := ctx.Param("namespace")
 	name := ctx.Param("podName")
-	pods, err := p.Client.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	cached, err := cluster.UseCache(ctx, p.cache(ctx), true)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if cached {
+		pod, err := p.cache(ctx).Pods().Pods(namespace).Get(name)
+		if err != nil {
+			common.Error(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusOK, pod)
+		return
+	}
+	pods, err := p.client(ctx).CoreV1().Pods(namespace).Get(ctx.Request.Context(), name, metav1.GetOptions{})
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, err)
+		common.Error(ctx, err)
 		return
 	}
 	ctx.JSON(http.StatusOK, pods)
 }
+
+// PatchPod
+// @Summary			Patch Pod.
+// @Description		Apply a JSON patch, merge patch, strategic merge patch or server-side apply patch, selected by the Content-Type.
+// @Tags			pod
+// @Router			/api/v1/namespaces/{namespace}/pods/{podName} [patch]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			podName path string true "Pod name"
+// @Param 			request body object true "Patch document"
+// @Param 			fieldManager query string false "Field manager recorded for the change, required for apply patches" default(go-kubernetes)
+// @Param 			force query bool false "Force an apply patch, taking ownership of conflicting fields"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} GetPodResponse
+// @Failure			400,401,403,404,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json-patch+json,application/merge-patch+json,application/strategic-merge-patch+json,application/apply-patch+yaml
+// @Produce			application/json
+func (p *Controller) PatchPod(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("podName")
+	patch, err := common.DecodePatch(ctx)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := p.client(ctx).CoreV1().Pods(namespace).Patch(ctx.Request.Context(), name, patch.Type, patch.Data, patch.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// GetPodLog
+// @Summary			Get Pod logs.
+// @Description		Return the logs of a Pod container. With follow=true the logs are streamed until the client disconnects, as Server-Sent Events when requested with Accept: text/event-stream or format=sse.
+// @Tags			pod
+// @Router			/api/v1/namespaces/{namespace}/pods/{podName}/log [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			podName path string true "Pod name"
+// @Param 			container query string false "Container name, required when the Pod has more than one container"
+// @Param 			follow query bool false "Stream the logs until the client disconnects"
+// @Param 			previous query bool false "Return the logs of the previous terminated container"
+// @Param 			timestamps query bool false "Prefix every line with an RFC3339 timestamp"
+// @Param 			tailLines query int false "Number of lines from the end of the logs to show"
+// @Param 			sinceSeconds query int false "Only return logs newer than this many seconds"
+// @Param 			sinceTime query string false "Only return logs after this RFC3339 timestamp"
+// @Param 			limitBytes query int false "Maximum number of bytes to return"
+// @Param 			format query string false "Set to sse to receive Server-Sent Events" Enums(sse)
+// @Response		200 {string} string
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			text/plain,text/event-stream
+func (p *Controller) GetPodLog(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("podName")
+	query, err := BindLogQuery(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	options, err := query.PodLogOptions()
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	stream, err := p.client(ctx).CoreV1().Pods(namespace).GetLogs(name, options).Stream(ctx.Request.Context())
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	defer stream.Close()
+
+	sse := common.WantsEventStream(ctx)
+	if sse {
+		common.StartEventStream(ctx)
+	} else {
+		ctx.Header("Content-Type", "text/plain; charset=utf-8")
+		ctx.Status(http.StatusOK)
+	}
+	scanner := NewLogScanner(stream)
+	for scanner.Scan() {
+		if sse {
+			common.SendEvent(ctx, "log", scanner.Text())
+			continue
+		}
+		_, _ = ctx.Writer.WriteString(scanner.Text() + "\n")
+		if options.Follow {
+			ctx.Writer.Flush()
+		}
+	}
+	if err := scanner.Err(); err != nil && ctx.Request.Context().Err() == nil && sse {
+		common.StreamError(ctx, err)
+		return
+	}
+	if sse {
+		common.SendEvent(ctx, "end", "")
+	}
+}
+
+// ExecPod
+// @Summary			Open an interactive shell in a Pod container.
+// @Description		Upgrade to a WebSocket proxying a remote command. The client sends {"type":"stdin","data":"..."} and {"type":"resize","cols":80,"rows":24} messages; the server sends "stdout", "stderr" and a final "exit" message carrying exitCode.
+// @Tags			pod
+// @Router			/api/v1/namespaces/{namespace}/pods/{podName}/exec [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			podName path string true "Pod name"
+// @Param 			container query string false "Container name, required when the Pod has more than one container"
+// @Param 			command query []string false "Command and arguments, defaults to /bin/sh" collectionFormat(multi)
+// @Param 			tty query bool false "Allocate a terminal" default(true)
+// @Response		101 {string} string "Switching Protocols"
+// @Failure			400 {object} common.ErrorResponse
+func (p *Controller) ExecPod(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("podName")
+	tty, err := strconv.ParseBool(ctx.DefaultQuery("tty", "true"))
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	command := ctx.QueryArray("command")
+	if len(command) == 0 {
+		command = defaultShell
+	}
+	if !websocket.IsWebSocketUpgrade(ctx.Request) {
+		common.BadRequest(ctx, errors.New("exec requires a WebSocket upgrade, use POST for non-interactive commands"))
+		return
+	}
+	conn, err := upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	runCtx, cancel := context.WithCancel(ctx.Request.Context())
+	defer cancel()
+	session, stdin := newExecSession(conn)
+	go session.readLoop(stdin, cancel)
+
+	options := execOptions{
+		namespace: namespace,
+		pod:       name,
+		container: ctx.Query("container"),
+		command:   command,
+		stdin:     session.stdin,
+		stdout:    session.writer("stdout"),
+		tty:       tty,
+	}
+	if tty {
+		options.sizes = session
+	} else {
+		options.stderr = session.writer("stderr")
+	}
+	err = p.stream(ctx, runCtx, options)
+	exit := ExecMessage{Type: "exit"}
+	if code, ok := exitCode(err); ok {
+		exit.ExitCode = &code
+	} else {
+		exit.Error = err.Error()
+	}
+	_ = session.send(exit)
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(execWriteTimeout))
+}
+
+// RunPodCommand
+// @Summary			Run a command in a Pod container.
+// @Description		Run a non-interactive command and return its exit code and captured output.
+// @Tags			pod
+// @Router			/api/v1/namespaces/{namespace}/pods/{podName}/exec [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			podName path string true "Pod name"
+// @Param 			request body ExecRequest true "Command to run"
+// @Response		200 {object} ExecResponse
+// @Failure			400,401,403,404,500,504 {object} common.ErrorResponse
+// @Accept			application/json
+// @Produce			application/json
+func (p *Controller) RunPodCommand(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("podName")
+	var request ExecRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	timeout := defaultExecTimeout
+	if request.TimeoutSeconds > 0 {
+		timeout = time.Duration(request.TimeoutSeconds) * time.Second
+	}
+	if timeout > maxExecTimeout {
+		common.BadRequest(ctx, fmt.Errorf("timeoutSeconds must not exceed %d", int(maxExecTimeout.Seconds())))
+		return
+	}
+	runCtx, cancel := context.WithTimeout(ctx.Request.Context(), timeout)
+	defer cancel()
+
+	stdout := &limitedBuffer{limit: maxExecOutput}
+	stderr := &limitedBuffer{limit: maxExecOutput}
+	options := execOptions{
+		namespace: namespace,
+		pod:       name,
+		container: request.Container,
+		command:   request.Command,
+		stdout:    stdout,
+		stderr:    stderr,
+	}
+	if request.Stdin != "" {
+		options.stdin = strings.NewReader(request.Stdin)
+	}
+	err := p.stream(ctx, runCtx, options)
+	code, ok := exitCode(err)
+	if !ok {
+		if runCtx.Err() != nil {
+			err = runCtx.Err()
+		}
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, ExecResponse{
+		ExitCode:  code,
+		Stdout:    stdout.String(),
+		Stderr:    stderr.String(),
+		Truncated: stdout.truncated || stderr.truncated,
+	})
+}
+
+// DeletePod
+// @Summary			Delete Pod.
+// @Tags			pod
+// @Router			/api/v1/namespaces/{namespace}/pods/{podName} [delete]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			podName path string true "Pod name"
+// @Param 			gracePeriodSeconds query int false "Seconds the pod is given to terminate, 0 deletes immediately"
+// @Param 			propagationPolicy query string false "Whether and how dependents are garbage collected" Enums(Orphan, Background, Foreground)
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @response     	default {boolean}  boolean true
+// @Failure			400,401,403,404,409,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (p *Controller) DeletePod(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("podName")
+	options, err := deleteOptions(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if err := p.client(ctx).CoreV1().Pods(namespace).Delete(ctx.Request.Context(), name, options); err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, true)
+}
+
+// EvictPod
+// @Summary			Evict Pod.
+// @Description		Evict a pod through the policy/v1 Eviction subresource so PodDisruptionBudgets are respected. Returns 429 when a budget does not allow the disruption right now.
+// @Tags			pod
+// @Router			/api/v1/namespaces/{namespace}/pods/{podName}/evict [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			podName path string true "Pod name"
+// @Param 			gracePeriodSeconds query int false "Seconds the pod is given to terminate"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @response     	default {boolean}  boolean true
+// @Failure			400,401,403,404,429,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (p *Controller) EvictPod(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("podName")
+	options, err := deleteOptions(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if err := evictPod(ctx.Request.Context(), p.client(ctx), namespace, name, options); err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, true)
+}
+
+// evictPod evicts a pod through the Eviction subresource.
+func evictPod(ctx context.Context, client kubernetes.Interface, namespace, name string, options metav1.DeleteOptions) error {
+	return client.PolicyV1().Evictions(namespace).Evict(ctx, &policyv1.Eviction{
+		ObjectMeta:    metav1.ObjectMeta{Name: name, Namespace: namespace},
+		DeleteOptions: &options,
+	})
+}
+
+// RestartPod
+// @Summary			Restart Pod.
+// @Description		Delete a pod owned by a controller and wait until the controller created a Ready replacement. With evict=true the pod is evicted so PodDisruptionBudgets are respected.
+// @Tags			pod
+// @Router			/api/v1/namespaces/{namespace}/pods/{podName}/restart [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			podName path string true "Pod name"
+// @Param 			evict query bool false "Evict the pod instead of deleting it"
+// @Param 			gracePeriodSeconds query int false "Seconds the pod is given to terminate"
+// @Param 			wait query bool false "Wait for the replacement to become Ready" default(true)
+// @Param 			timeout query string false "Maximum time to wait, e.g. 90s" default(5m)
+// @Response		200 {object} RestartPodResponse
+// @Failure			400,401,403,404,409,429,500,504 {object} common.ErrorResponse
+// @Produce			application/json
+func (p *Controller) RestartPod(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("podName")
+	evict, err := strconv.ParseBool(ctx.DefaultQuery("evict", "false"))
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	shouldWait, err := strconv.ParseBool(ctx.DefaultQuery("wait", "true"))
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	timeout, err := parseRestartTimeout(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	options, err := deleteOptions(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if options.DryRun != nil || options.PropagationPolicy != nil {
+		common.BadRequest(ctx, errors.New("dryRun and propagationPolicy are not supported by restart"))
+		return
+	}
+
+	client := p.client(ctx)
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		common.Error(ctx, apierrors.NewBadRequest(fmt.Sprintf("pod %q is not owned by a controller and would not be recreated", name)))
+		return
+	}
+	existing, err := siblingUIDs(ctx.Request.Context(), client, pod, owner)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	uid := pod.UID
+	options.Preconditions = &metav1.Preconditions{UID: &uid}
+	if evict {
+		err = evictPod(ctx.Request.Context(), client, namespace, name, options)
+	} else {
+		err = client.CoreV1().Pods(namespace).Delete(ctx.Request.Context(), name, options)
+	}
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+
+	response := RestartPodResponse{Deleted: name, Controller: owner.Kind + "/" + owner.Name}
+	if shouldWait {
+		response.Replacement, err = waitForReplacement(ctx.Request.Context(), client, pod, owner, existing, timeout)
+		if err != nil {
+			common.Error(ctx, err)
+			return
+		}
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// DiagnosePod
+// @Summary			Diagnose Pod.
+// @Description		Explain why a pod is unhealthy. Correlates container states, termination reasons and exit codes, scheduling and readiness conditions, warning events and missing ConfigMaps, Secrets and PVCs into findings ordered by severity.
+// @Tags			pod
+// @Router			/api/v1/namespaces/{namespace}/pods/{podName}/diagnose [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			podName path string true "Pod name"
+// @Response		200 {object} DiagnoseResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (p *Controller) DiagnosePod(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("podName")
+	client := p.client(ctx)
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	response, err := diagnose(ctx.Request.Context(), client, pod)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,67 @@
+package pod
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestListPodWaitingReason(t *testing.T) {
+	crashing := newTestPod("crashing")
+	crashing.Status.ContainerStatuses = []v1.ContainerStatus{{
+		Name:  "app",
+		State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+	}}
+	initializing := newTestPod("initializing")
+	initializing.Status.InitContainerStatuses = []v1.ContainerStatus{{
+		Name:  "migrate",
+		State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+	}}
+	pulling := newTestPod("pulling")
+	pulling.Status.ContainerStatuses = []v1.ContainerStatus{{
+		Name:  "app",
+		State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+	}}
+	router := newTestRouter(fake.NewSimpleClientset(crashing, initializing, pulling, newTestPod("running")))
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+		want     []string
+	}{
+		{name: "all pods", wantCode: http.StatusOK, want: []string{"crashing", "initializing", "pulling", "running"}},
+		{name: "waiting reason", query: "?waitingReason=CrashLoopBackOff", wantCode: http.StatusOK, want: []string{"crashing", "initializing"}},
+		{name: "no match", query: "?waitingReason=ErrImagePull", wantCode: http.StatusOK, want: []string{}},
+		{name: "waiting reason with limit", query: "?waitingReason=CrashLoopBackOff&limit=2", wantCode: http.StatusBadRequest},
+		{name: "waiting reason with watch", query: "?waitingReason=CrashLoopBackOff&watch=true", wantCode: http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/namespaces/default/pods"+test.query, nil))
+			if recorder.Code != test.wantCode {
+				t.Fatalf("got status %d, want %d: %s", recorder.Code, test.wantCode, recorder.Body.String())
+			}
+			if test.want == nil {
+				return
+			}
+			var list v1.PodList
+			if err := json.Unmarshal(recorder.Body.Bytes(), &list); err != nil {
+				t.Fatal(err)
+			}
+			names := []string{}
+			for _, pod := range list.Items {
+				names = append(names, pod.Name)
+			}
+			if !reflect.DeepEqual(names, test.want) {
+				t.Errorf("got pods %v, want %v", names, test.want)
+			}
+		})
+	}
+}
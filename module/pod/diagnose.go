@@ -0,0 +1,393 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"sort"
+	"strings"
+)
+
+// Severity ranks a finding; findings are returned most severe first.
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityWarning  Severity = "warning"
+	SeverityInfo     Severity = "info"
+)
+
+var severityRank = map[Severity]int{
+	SeverityCritical: 0,
+	SeverityWarning:  1,
+	SeverityInfo:     2,
+}
+
+type Finding struct {
+	Severity Severity `json:"severity"`
+	// Reason is a short machine readable cause, e.g. CrashLoopBackOff.
+	Reason    string `json:"reason"`
+	Container string `json:"container,omitempty"`
+	// Message is what was observed on the pod or its events.
+	Message string `json:"message"`
+	// Explanation says what the reason usually means and where to look next.
+	Explanation string `json:"explanation"`
+}
+
+type DiagnoseResponse struct {
+	Name      string      `json:"name"`
+	Namespace string      `json:"namespace"`
+	Phase     v1.PodPhase `json:"phase"`
+	Ready     bool        `json:"ready"`
+	Findings  []Finding   `json:"findings"`
+	// Events are the warning events recorded for the pod.
+	Events []v1.Event `json:"events"`
+}
+
+var waitingExplanations = map[string]string{
+	"CrashLoopBackOff":           "The container keeps exiting and the kubelet waits longer before each restart. Check the logs of the previous run and its last termination reason.",
+	"ImagePullBackOff":           "The image could not be pulled and the kubelet backs off before retrying. Check the image name and tag, that the registry is reachable and that imagePullSecrets grant access.",
+	"ErrImagePull":               "Pulling the image failed. Check the image name and tag, that the registry is reachable and that imagePullSecrets grant access.",
+	"InvalidImageName":           "The image reference cannot be parsed. Fix the image field of the container.",
+	"CreateContainerConfigError": "The container configuration could not be generated, usually because a referenced ConfigMap, Secret or key does not exist.",
+	"CreateContainerError":       "The container runtime failed to create the container. The message usually names the failing mount or command.",
+	"RunContainerError":          "The container runtime failed to start the container, often because the command or entrypoint does not exist in the image.",
+}
+
+var exitCodeExplanations = map[int32]string{
+	1:   "The application exited with a generic error; its logs should say why.",
+	2:   "The shell or application reported a misuse, often invalid arguments.",
+	126: "The command was found but is not executable.",
+	127: "The command was not found in the image; check command and args.",
+	137: "The container was killed with SIGKILL, usually by the OOM killer or after not stopping within the grace period.",
+	139: "The container crashed with a segmentation fault.",
+	143: "The container was stopped with SIGTERM.",
+}
+
+var eventExplanations = map[string]string{
+	"Unhealthy":          "A liveness, readiness or startup probe is failing. Check the probe path, port and timeouts against how long the application needs to respond.",
+	"FailedMount":        "A volume could not be mounted. Check that the referenced ConfigMap, Secret or PVC exists and that the volume is attached to the node.",
+	"FailedAttachVolume": "The volume could not be attached to the node, often because it is still attached to another node or is in a different zone.",
+	"FailedScheduling":   "The scheduler found no node for the pod.",
+	"BackOff":            "The kubelet is backing off restarting a failed container or pulling an image.",
+	"Failed":             "The kubelet failed to pull the image or start the container.",
+	"Evicted":            "The pod was evicted, usually because the node ran low on memory or disk.",
+}
+
+// diagnose inspects the pod, its warning events and the objects it references
+// and returns the findings ordered by severity.
+func diagnose(ctx context.Context, client kubernetes.Interface, pod *v1.Pod) (DiagnoseResponse, error) {
+	response := DiagnoseResponse{
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Phase:     pod.Status.Phase,
+		Ready:     IsReady(pod),
+		Findings:  []Finding{},
+		Events:    []v1.Event{},
+	}
+	events, err := client.CoreV1().Events(pod.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.Set{
+			"involvedObject.kind": "Pod",
+			"involvedObject.name": pod.Name,
+		}.String(),
+	})
+	if err != nil {
+		return response, err
+	}
+	for _, event := range events.Items {
+		if event.Type == v1.EventTypeWarning && event.InvolvedObject.Name == pod.Name && (event.InvolvedObject.UID == "" || event.InvolvedObject.UID == pod.UID) {
+			response.Events = append(response.Events, event)
+		}
+	}
+
+	findings := podFindings(pod)
+	for _, statuses := range [][]v1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, status := range statuses {
+			findings = append(findings, containerFindings(status)...)
+		}
+	}
+	findings = append(findings, eventFindings(response.Events)...)
+	missing, err := missingReferences(ctx, client, pod)
+	if err != nil {
+		return response, err
+	}
+	findings = append(findings, missing...)
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return severityRank[findings[i].Severity] < severityRank[findings[j].Severity]
+	})
+	response.Findings = append(response.Findings, findings...)
+	return response, nil
+}
+
+// podFindings reports problems visible on the pod itself: eviction, scheduling and readiness.
+func podFindings(pod *v1.Pod) []Finding {
+	var findings []Finding
+	if pod.Status.Phase == v1.PodFailed {
+		findings = append(findings, Finding{
+			Severity:    SeverityCritical,
+			Reason:      nonEmpty(pod.Status.Reason, "Failed"),
+			Message:     nonEmpty(pod.Status.Message, "the pod failed"),
+			Explanation: nonEmpty(eventExplanations[pod.Status.Reason], "All containers terminated and at least one failed; it will not be restarted."),
+		})
+	}
+	if pod.DeletionTimestamp != nil {
+		findings = append(findings, Finding{
+			Severity:    SeverityInfo,
+			Reason:      "Terminating",
+			Message:     fmt.Sprintf("deletion requested at %s", pod.DeletionTimestamp.UTC().Format("2006-01-02T15:04:05Z")),
+			Explanation: "The pod is shutting down. If it stays terminating, check its finalizers and whether the node is reachable.",
+		})
+	}
+	for _, condition := range pod.Status.Conditions {
+		switch {
+		case condition.Type == v1.PodScheduled && condition.Status == v1.ConditionFalse:
+			findings = append(findings, Finding{
+				Severity:    SeverityCritical,
+				Reason:      nonEmpty(condition.Reason, "Unschedulable"),
+				Message:     condition.Message,
+				Explanation: "No node satisfies the pod's resource requests, node selector, affinity or tolerations. The message lists why each node was rejected.",
+			})
+		case condition.Type == v1.PodReady && condition.Status == v1.ConditionFalse && pod.Status.Phase == v1.PodRunning:
+			findings = append(findings, Finding{
+				Severity:    SeverityWarning,
+				Reason:      "NotReady",
+				Message:     nonEmpty(condition.Message, "the pod is running but not ready"),
+				Explanation: "Services do not route traffic to the pod until every container passes its readiness probe.",
+			})
+		}
+	}
+	return findings
+}
+
+// containerFindings reports the waiting state, current and last termination of a container.
+func containerFindings(status v1.ContainerStatus) []Finding {
+	var findings []Finding
+	if waiting := status.State.Waiting; waiting != nil && waiting.Reason != "" && waiting.Reason != "ContainerCreating" && waiting.Reason != "PodInitializing" {
+		severity := SeverityCritical
+		explanation, ok := waitingExplanations[waiting.Reason]
+		if !ok {
+			severity = SeverityWarning
+			explanation = "The container is not running yet."
+		}
+		findings = append(findings, Finding{
+			Severity:    severity,
+			Reason:      waiting.Reason,
+			Container:   status.Name,
+			Message:     nonEmpty(waiting.Message, fmt.Sprintf("container is waiting: %s", waiting.Reason)),
+			Explanation: explanation,
+		})
+	}
+	if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+		findings = append(findings, terminationFinding(status.Name, terminated, SeverityCritical, "Terminated"))
+	}
+	if terminated := status.LastTerminationState.Terminated; terminated != nil && (terminated.ExitCode != 0 || terminated.Reason == "OOMKilled") {
+		severity := SeverityWarning
+		if status.State.Waiting != nil {
+			severity = SeverityCritical
+		}
+		findings = append(findings, terminationFinding(status.Name, terminated, severity, "LastTerminated"))
+	}
+	if status.RestartCount > 0 && status.LastTerminationState.Terminated == nil {
+		findings = append(findings, Finding{
+			Severity:    SeverityInfo,
+			Reason:      "Restarted",
+			Container:   status.Name,
+			Message:     fmt.Sprintf("container restarted %d times", status.RestartCount),
+			Explanation: "The container has been restarted before; its previous logs may explain why.",
+		})
+	}
+	return findings
+}
+
+func terminationFinding(container string, terminated *v1.ContainerStateTerminated, severity Severity, prefix string) Finding {
+	reason := nonEmpty(terminated.Reason, "Error")
+	message := fmt.Sprintf("exited with code %d (%s)", terminated.ExitCode, reason)
+	if terminated.Message != "" {
+		message += ": " + terminated.Message
+	}
+	explanation := exitCodeExplanations[terminated.ExitCode]
+	if reason == "OOMKilled" {
+		explanation = "The container used more memory than its limit and was killed. Raise resources.limits.memory or reduce the memory usage of the application."
+	}
+	if explanation == "" {
+		explanation = "The application exited with a non-zero code; its logs should say why."
+	}
+	return Finding{
+		Severity:    severity,
+		Reason:      prefix + ":" + reason,
+		Container:   container,
+		Message:     message,
+		Explanation: explanation,
+	}
+}
+
+// eventFindings turns warning events into findings, one per reason, keeping the latest message.
+func eventFindings(events []v1.Event) []Finding {
+	latest := map[string]v1.Event{}
+	counts := map[string]int32{}
+	var reasons []string
+	for _, event := range events {
+		if _, ok := latest[event.Reason]; !ok {
+			reasons = append(reasons, event.Reason)
+		}
+		if previous, ok := latest[event.Reason]; !ok || !event.LastTimestamp.Before(&previous.LastTimestamp) {
+			latest[event.Reason] = event
+		}
+		counts[event.Reason] += max(event.Count, 1)
+	}
+	findings := make([]Finding, 0, len(reasons))
+	for _, reason := range reasons {
+		explanation, ok := eventExplanations[reason]
+		if !ok {
+			explanation = "See the event message for details."
+		}
+		findings = append(findings, Finding{
+			Severity:    SeverityWarning,
+			Reason:      "Event:" + reason,
+			Message:     fmt.Sprintf("%s (seen %d times)", latest[reason].Message, counts[reason]),
+			Explanation: explanation,
+		})
+	}
+	return findings
+}
+
+// Reference is a ConfigMap, Secret or PersistentVolumeClaim a pod spec refers to.
+type Reference struct {
+	Kind     string
+	Name     string
+	Optional bool
+	// UsedBy names the volume, container or field holding the reference.
+	UsedBy string
+}
+
+// SpecReferences collects the ConfigMaps, Secrets and PVCs a pod spec refers to.
+func SpecReferences(spec *v1.PodSpec) []Reference {
+	var refs []Reference
+	for _, volume := range spec.Volumes {
+		usedBy := "volume " + volume.Name
+		switch {
+		case volume.ConfigMap != nil:
+			refs = append(refs, Reference{"ConfigMap", volume.ConfigMap.Name, isTrue(volume.ConfigMap.Optional), usedBy})
+		case volume.Secret != nil:
+			refs = append(refs, Reference{"Secret", volume.Secret.SecretName, isTrue(volume.Secret.Optional), usedBy})
+		case volume.PersistentVolumeClaim != nil:
+			refs = append(refs, Reference{"PersistentVolumeClaim", volume.PersistentVolumeClaim.ClaimName, false, usedBy})
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					refs = append(refs, Reference{"ConfigMap", source.ConfigMap.Name, isTrue(source.ConfigMap.Optional), usedBy})
+				}
+				if source.Secret != nil {
+					refs = append(refs, Reference{"Secret", source.Secret.Name, isTrue(source.Secret.Optional), usedBy})
+				}
+			}
+		}
+	}
+	for _, containers := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for _, container := range containers {
+			usedBy := "container " + container.Name
+			for _, envFrom := range container.EnvFrom {
+				if envFrom.ConfigMapRef != nil {
+					refs = append(refs, Reference{"ConfigMap", envFrom.ConfigMapRef.Name, isTrue(envFrom.ConfigMapRef.Optional), usedBy})
+				}
+				if envFrom.SecretRef != nil {
+					refs = append(refs, Reference{"Secret", envFrom.SecretRef.Name, isTrue(envFrom.SecretRef.Optional), usedBy})
+				}
+			}
+			for _, env := range container.Env {
+				if env.ValueFrom == nil {
+					continue
+				}
+				if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil {
+					refs = append(refs, Reference{"ConfigMap", ref.Name, isTrue(ref.Optional), usedBy + " env " + env.Name})
+				}
+				if ref := env.ValueFrom.SecretKeyRef; ref != nil {
+					refs = append(refs, Reference{"Secret", ref.Name, isTrue(ref.Optional), usedBy + " env " + env.Name})
+				}
+			}
+		}
+	}
+	for _, secret := range spec.ImagePullSecrets {
+		refs = append(refs, Reference{"Secret", secret.Name, false, "imagePullSecrets"})
+	}
+	return refs
+}
+
+// missingReferences looks up every referenced object and reports the missing
+// ones and PVCs that are not bound.
+func missingReferences(ctx context.Context, client kubernetes.Interface, pod *v1.Pod) ([]Finding, error) {
+	var findings []Finding
+	for _, ref := range mergeReferences(SpecReferences(&pod.Spec)) {
+		var err error
+		switch ref.Kind {
+		case "ConfigMap":
+			_, err = client.CoreV1().ConfigMaps(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		case "Secret":
+			_, err = client.CoreV1().Secrets(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		case "PersistentVolumeClaim":
+			var claim *v1.PersistentVolumeClaim
+			claim, err = client.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+			if err == nil && claim.Status.Phase != v1.ClaimBound {
+				findings = append(findings, Finding{
+					Severity:    SeverityCritical,
+					Reason:      "UnboundPersistentVolumeClaim",
+					Message:     fmt.Sprintf("PersistentVolumeClaim %s used by %s is %s", ref.Name, ref.UsedBy, nonEmpty(string(claim.Status.Phase), "not bound")),
+					Explanation: "The pod cannot start until the claim is bound. Check the storage class and whether a volume can be provisioned.",
+				})
+			}
+		}
+		switch {
+		case apierrors.IsNotFound(err):
+			severity := SeverityCritical
+			if ref.Optional {
+				severity = SeverityInfo
+			}
+			findings = append(findings, Finding{
+				Severity:    severity,
+				Reason:      "Missing" + ref.Kind,
+				Message:     fmt.Sprintf("%s %s used by %s does not exist", ref.Kind, ref.Name, ref.UsedBy),
+				Explanation: fmt.Sprintf("Create the %s in namespace %s or fix the reference in the pod spec.", ref.Kind, pod.Namespace),
+			})
+		case apierrors.IsForbidden(err):
+			// Not being allowed to read the object says nothing about the pod.
+		case err != nil:
+			return nil, err
+		}
+	}
+	return findings, nil
+}
+
+// mergeReferences folds the references to the same object into one that is
+// optional only if every use of it is optional and names every user.
+func mergeReferences(refs []Reference) []Reference {
+	var merged []Reference
+	index := map[string]int{}
+	for _, ref := range refs {
+		key := ref.Kind + "/" + ref.Name
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			merged = append(merged, ref)
+			continue
+		}
+		merged[i].Optional = merged[i].Optional && ref.Optional
+		merged[i].UsedBy += ", " + ref.UsedBy
+	}
+	return merged
+}
+
+func nonEmpty(value, fallback string) string {
+	if strings.TrimSpace(value) == "" {
+		return fallback
+	}
+	return value
+}
+
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
@@ -0,0 +1,318 @@
+package pod
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestContainerFindings(t *testing.T) {
+	tests := []struct {
+		name   string
+		status v1.ContainerStatus
+		want   map[string]Severity
+	}{
+		{
+			name: "crash loop",
+			status: v1.ContainerStatus{
+				State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}},
+				RestartCount:         4,
+			},
+			want: map[string]Severity{"CrashLoopBackOff": SeverityCritical, "LastTerminated:Error": SeverityCritical},
+		},
+		{
+			name:   "image pull back-off",
+			status: v1.ContainerStatus{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "pull access denied"}}},
+			want:   map[string]Severity{"ImagePullBackOff": SeverityCritical},
+		},
+		{
+			name:   "create container config error",
+			status: v1.ContainerStatus{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CreateContainerConfigError"}}},
+			want:   map[string]Severity{"CreateContainerConfigError": SeverityCritical},
+		},
+		{
+			name: "oom killed and running again",
+			status: v1.ContainerStatus{
+				State:                v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+				LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+				RestartCount:         1,
+			},
+			want: map[string]Severity{"LastTerminated:OOMKilled": SeverityWarning},
+		},
+		{
+			name:   "terminated with an error",
+			status: v1.ContainerStatus{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 127}}},
+			want:   map[string]Severity{"Terminated:Error": SeverityCritical},
+		},
+		{
+			name:   "unknown waiting reason",
+			status: v1.ContainerStatus{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "SomethingNew"}}},
+			want:   map[string]Severity{"SomethingNew": SeverityWarning},
+		},
+		{
+			name:   "restarted without a last state",
+			status: v1.ContainerStatus{State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}, RestartCount: 2},
+			want:   map[string]Severity{"Restarted": SeverityInfo},
+		},
+		{
+			name:   "creating",
+			status: v1.ContainerStatus{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+			want:   map[string]Severity{},
+		},
+		{
+			name:   "completed",
+			status: v1.ContainerStatus{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}}},
+			want:   map[string]Severity{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.status.Name = "app"
+			got := map[string]Severity{}
+			for _, finding := range containerFindings(test.status) {
+				if finding.Container != "app" {
+					t.Errorf("finding %q names container %q", finding.Reason, finding.Container)
+				}
+				got[finding.Reason] = finding.Severity
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPodFindings(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      v1.PodStatus
+		want        map[string]Severity
+		wantMessage string
+	}{
+		{
+			name: "unschedulable",
+			status: v1.PodStatus{
+				Phase: v1.PodPending,
+				Conditions: []v1.PodCondition{{
+					Type:    v1.PodScheduled,
+					Status:  v1.ConditionFalse,
+					Reason:  "Unschedulable",
+					Message: "0/3 nodes are available: 3 Insufficient memory.",
+				}},
+			},
+			want:        map[string]Severity{"Unschedulable": SeverityCritical},
+			wantMessage: "Insufficient memory",
+		},
+		{
+			name: "running but not ready",
+			status: v1.PodStatus{
+				Phase:      v1.PodRunning,
+				Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}},
+			},
+			want: map[string]Severity{"NotReady": SeverityWarning},
+		},
+		{
+			name:        "evicted",
+			status:      v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted", Message: "The node was low on resource: memory."},
+			want:        map[string]Severity{"Evicted": SeverityCritical},
+			wantMessage: "low on resource",
+		},
+		{
+			name: "healthy",
+			status: v1.PodStatus{
+				Phase:      v1.PodRunning,
+				Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}, {Type: v1.PodReady, Status: v1.ConditionTrue}},
+			},
+			want: map[string]Severity{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := newTestPod("web")
+			pod.Status = test.status
+			got := map[string]Severity{}
+			for _, finding := range podFindings(pod) {
+				got[finding.Reason] = finding.Severity
+				if !strings.Contains(finding.Message, test.wantMessage) {
+					t.Errorf("got message %q, want it to contain %q", finding.Message, test.wantMessage)
+				}
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDiagnosePod(t *testing.T) {
+	secretVolume := func(optional bool) v1.Volume {
+		return v1.Volume{Name: "tls", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "tls", Optional: ptr.To(optional)}}}
+	}
+	secretEnvFrom := func(optional bool) v1.EnvFromSource {
+		return v1.EnvFromSource{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "tls"}, Optional: ptr.To(optional)}}
+	}
+	tests := []struct {
+		name    string
+		pod     func(*v1.Pod)
+		objects []runtime.Object
+		// want lists the reason and severity of each finding in order.
+		want        []Finding
+		wantContain string
+	}{
+		{
+			name: "healthy",
+			pod:  func(*v1.Pod) {},
+			want: []Finding{},
+		},
+		{
+			name: "missing configmap",
+			pod: func(pod *v1.Pod) {
+				pod.Spec.Volumes = []v1.Volume{{Name: "config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "settings"}}}}}
+			},
+			want:        []Finding{{Reason: "MissingConfigMap", Severity: SeverityCritical}},
+			wantContain: "ConfigMap settings used by volume config does not exist",
+		},
+		{
+			name: "existing configmap",
+			pod: func(pod *v1.Pod) {
+				pod.Spec.Volumes = []v1.Volume{{Name: "config", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "settings"}}}}}
+			},
+			objects: []runtime.Object{&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"}}},
+			want:    []Finding{},
+		},
+		{
+			name: "missing optional secret",
+			pod: func(pod *v1.Pod) {
+				pod.Spec.Volumes = []v1.Volume{secretVolume(true)}
+				pod.Spec.Containers[0].EnvFrom = []v1.EnvFromSource{secretEnvFrom(true)}
+			},
+			want: []Finding{{Reason: "MissingSecret", Severity: SeverityInfo}},
+		},
+		{
+			name: "secret optional as a volume but required by envFrom",
+			pod: func(pod *v1.Pod) {
+				pod.Spec.Volumes = []v1.Volume{secretVolume(true)}
+				pod.Spec.Containers[0].EnvFrom = []v1.EnvFromSource{secretEnvFrom(false)}
+			},
+			want:        []Finding{{Reason: "MissingSecret", Severity: SeverityCritical}},
+			wantContain: "Secret tls used by volume tls, container app does not exist",
+		},
+		{
+			name: "secret required by a key reference",
+			pod: func(pod *v1.Pod) {
+				pod.Spec.Volumes = []v1.Volume{secretVolume(true)}
+				pod.Spec.Containers[0].Env = []v1.EnvVar{{Name: "PASSWORD", ValueFrom: &v1.EnvVarSource{
+					SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "tls"}, Key: "password"},
+				}}}
+			},
+			want:        []Finding{{Reason: "MissingSecret", Severity: SeverityCritical}},
+			wantContain: "container app env PASSWORD",
+		},
+		{
+			name: "missing pvc",
+			pod: func(pod *v1.Pod) {
+				pod.Spec.Volumes = []v1.Volume{{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "data"}}}}
+			},
+			want: []Finding{{Reason: "MissingPersistentVolumeClaim", Severity: SeverityCritical}},
+		},
+		{
+			name: "pending pvc",
+			pod: func(pod *v1.Pod) {
+				pod.Spec.Volumes = []v1.Volume{{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "data"}}}}
+			},
+			objects: []runtime.Object{&v1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"},
+				Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+			}},
+			want:        []Finding{{Reason: "UnboundPersistentVolumeClaim", Severity: SeverityCritical}},
+			wantContain: "is Pending",
+		},
+		{
+			name: "ranked by severity",
+			pod: func(pod *v1.Pod) {
+				pod.Spec.Volumes = []v1.Volume{secretVolume(true)}
+				pod.Status.Phase = v1.PodRunning
+				pod.Status.ContainerStatuses = []v1.ContainerStatus{{
+					Name:         "app",
+					State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					RestartCount: 3,
+				}}
+			},
+			objects: []runtime.Object{
+				&v1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: "web.1", Namespace: "default"},
+					InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "web", UID: "uid-web"},
+					Type:           v1.EventTypeWarning,
+					Reason:         "BackOff",
+					Message:        "Back-off restarting failed container",
+					Count:          5,
+				},
+				&v1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: "web.2", Namespace: "default"},
+					InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "web", UID: "uid-web"},
+					Type:           v1.EventTypeNormal,
+					Reason:         "Pulled",
+				},
+				&v1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: "web.3", Namespace: "default"},
+					InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "web", UID: "uid-previous-web"},
+					Type:           v1.EventTypeWarning,
+					Reason:         "FailedMount",
+				},
+			},
+			want: []Finding{
+				{Reason: "CrashLoopBackOff", Severity: SeverityCritical},
+				{Reason: "Event:BackOff", Severity: SeverityWarning},
+				{Reason: "Restarted", Severity: SeverityInfo},
+				{Reason: "MissingSecret", Severity: SeverityInfo},
+			},
+			wantContain: "seen 5 times",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := newTestPod("web")
+			test.pod(pod)
+			router := newTestRouter(fake.NewSimpleClientset(append(test.objects, pod)...))
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/namespaces/default/pods/web/diagnose", nil))
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("got status %d: %s", recorder.Code, recorder.Body.String())
+			}
+			if !strings.Contains(recorder.Body.String(), test.wantContain) {
+				t.Errorf("body %s does not contain %q", recorder.Body.String(), test.wantContain)
+			}
+			var response DiagnoseResponse
+			if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+				t.Fatal(err)
+			}
+			got := []Finding{}
+			for _, finding := range response.Findings {
+				got = append(got, Finding{Reason: finding.Reason, Severity: finding.Severity})
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got findings %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDiagnosePodNotFound(t *testing.T) {
+	router := newTestRouter(fake.NewSimpleClientset())
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/namespaces/default/pods/web/diagnose", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("got status %d, want %d", recorder.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,216 @@
+package pod
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"io"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+	"sync"
+	"time"
+)
+
+const (
+	execWriteTimeout   = 10 * time.Second
+	defaultExecTimeout = 60 * time.Second
+	maxExecTimeout     = 10 * time.Minute
+	// maxExecOutput bounds the stdout and stderr captured by a non-interactive exec.
+	maxExecOutput = 4 * 1024 * 1024
+)
+
+var defaultShell = []string{"/bin/sh"}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+}
+
+// ExecMessage is the frame exchanged over the exec WebSocket. The client sends
+// "stdin" and "resize" messages, the server answers with "stdout", "stderr"
+// and a final "exit" message.
+type ExecMessage struct {
+	Type     string `json:"type"`
+	Data     string `json:"data,omitempty"`
+	Cols     uint16 `json:"cols,omitempty"`
+	Rows     uint16 `json:"rows,omitempty"`
+	ExitCode *int   `json:"exitCode,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ExecRequest is the body of a non-interactive exec.
+type ExecRequest struct {
+	Container      string   `json:"container"`
+	Command        []string `json:"command" binding:"required,min=1"`
+	Stdin          string   `json:"stdin"`
+	TimeoutSeconds int      `json:"timeoutSeconds"`
+}
+
+// ExecResponse is the result of a non-interactive exec.
+type ExecResponse struct {
+	ExitCode  int    `json:"exitCode"`
+	Stdout    string `json:"stdout"`
+	Stderr    string `json:"stderr"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+type execOptions struct {
+	namespace string
+	pod       string
+	container string
+	command   []string
+	stdin     io.Reader
+	stdout    io.Writer
+	stderr    io.Writer
+	tty       bool
+	sizes     remotecommand.TerminalSizeQueue
+}
+
+// stream runs a command inside a container over an SPDY session.
+func (p *Controller) stream(ctx *gin.Context, runCtx context.Context, options execOptions) error {
+	request := p.client(ctx).CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(options.namespace).
+		Name(options.pod).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: options.container,
+			Command:   options.command,
+			Stdin:     options.stdin != nil,
+			Stdout:    options.stdout != nil,
+			Stderr:    options.stderr != nil,
+			TTY:       options.tty,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(p.restConfig(ctx), "POST", request.URL())
+	if err != nil {
+		return err
+	}
+	return executor.StreamWithContext(runCtx, remotecommand.StreamOptions{
+		Stdin:             options.stdin,
+		Stdout:            options.stdout,
+		Stderr:            options.stderr,
+		Tty:               options.tty,
+		TerminalSizeQueue: options.sizes,
+	})
+}
+
+// exitCode extracts the exit status of a finished command. A nil error means
+// the command exited with 0; ok is false when err is not an exit status.
+func exitCode(err error) (int, bool) {
+	if err == nil {
+		return 0, true
+	}
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitStatus(), true
+	}
+	return 0, false
+}
+
+// execSession proxies a remotecommand session over a WebSocket connection.
+type execSession struct {
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+	stdin   *io.PipeReader
+	sizes   chan remotecommand.TerminalSize
+}
+
+func newExecSession(conn *websocket.Conn) (*execSession, *io.PipeWriter) {
+	stdin, stdinWriter := io.Pipe()
+	return &execSession{
+		conn:  conn,
+		stdin: stdin,
+		sizes: make(chan remotecommand.TerminalSize, 1),
+	}, stdinWriter
+}
+
+// readLoop forwards stdin and resize messages from the browser until the
+// connection is closed, then cancels the session.
+func (s *execSession) readLoop(stdin *io.PipeWriter, cancel context.CancelFunc) {
+	defer cancel()
+	defer close(s.sizes)
+	defer stdin.Close()
+	for {
+		var message ExecMessage
+		if err := s.conn.ReadJSON(&message); err != nil {
+			return
+		}
+		switch message.Type {
+		case "stdin":
+			if _, err := stdin.Write([]byte(message.Data)); err != nil {
+				return
+			}
+		case "resize":
+			if message.Cols == 0 || message.Rows == 0 {
+				continue
+			}
+			size := remotecommand.TerminalSize{Width: message.Cols, Height: message.Rows}
+			select {
+			case s.sizes <- size:
+			default:
+				// Drop the pending size in favour of the latest one.
+				select {
+				case <-s.sizes:
+				default:
+				}
+				s.sizes <- size
+			}
+		}
+	}
+}
+
+// Next implements remotecommand.TerminalSizeQueue.
+func (s *execSession) Next() *remotecommand.TerminalSize {
+	size, ok := <-s.sizes
+	if !ok {
+		return nil
+	}
+	return &size
+}
+
+func (s *execSession) send(message ExecMessage) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(execWriteTimeout))
+	return s.conn.WriteJSON(message)
+}
+
+// writer returns an io.Writer sending everything written to it as messageType frames.
+func (s *execSession) writer(messageType string) io.Writer {
+	return execWriter{session: s, messageType: messageType}
+}
+
+type execWriter struct {
+	session     *execSession
+	messageType string
+}
+
+func (w execWriter) Write(p []byte) (int, error) {
+	if err := w.session.send(ExecMessage{Type: w.messageType, Data: string(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// limitedBuffer captures output up to a fixed size and remembers whether it was truncated.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	remaining := b.limit - b.Len()
+	if remaining < len(p) {
+		b.truncated = true
+		if remaining > 0 {
+			b.Buffer.Write(p[:remaining])
+		}
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,30 @@
+package pod
+
+import (
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+func newTestPod(name string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID("uid-" + name),
+			Labels:    map[string]string{"app": "web"},
+		},
+		Spec: v1.PodSpec{Containers: []v1.Container{{Name: "app", Image: "nginx"}}},
+	}
+}
+
+// newTestRouter mounts the pod routes on /namespaces/:namespace/pods.
+func newTestRouter(client kubernetes.Interface) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	route := NewPodRoute(NewPodController(&K8sClient{Client: client}))
+	router := gin.New()
+	route.Route(router.Group("/namespaces/:namespace/pods"))
+	return router
+}
@@ -0,0 +1,131 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/common"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"strconv"
+	"time"
+)
+
+const (
+	restartPollInterval   = time.Second
+	defaultRestartTimeout = 5 * time.Minute
+	maxRestartTimeout     = 30 * time.Minute
+)
+
+type RestartPodResponse struct {
+	// Deleted is the name of the pod that was removed.
+	Deleted string `json:"deleted"`
+	// Controller is the kind and name of the object that recreates the pod.
+	Controller string `json:"controller"`
+	// Replacement is the new Ready pod, empty when wait=false.
+	Replacement *v1.Pod `json:"replacement,omitempty"`
+}
+
+// deleteOptions reads the gracePeriodSeconds, propagationPolicy and dryRun query parameters.
+func deleteOptions(ctx *gin.Context) (metav1.DeleteOptions, error) {
+	var options metav1.DeleteOptions
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		return options, err
+	}
+	options.DryRun = dryRun
+	if value := ctx.Query("gracePeriodSeconds"); value != "" {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || seconds < 0 {
+			return options, fmt.Errorf("gracePeriodSeconds must be a non-negative integer, got %q", value)
+		}
+		options.GracePeriodSeconds = &seconds
+	}
+	switch policy := metav1.DeletionPropagation(ctx.Query("propagationPolicy")); policy {
+	case "":
+	case metav1.DeletePropagationOrphan, metav1.DeletePropagationBackground, metav1.DeletePropagationForeground:
+		options.PropagationPolicy = &policy
+	default:
+		return options, fmt.Errorf("propagationPolicy must be one of Orphan, Background or Foreground, got %q", policy)
+	}
+	return options, nil
+}
+
+// parseRestartTimeout reads the timeout query parameter of the restart action.
+func parseRestartTimeout(ctx *gin.Context) (time.Duration, error) {
+	value := ctx.Query("timeout")
+	if value == "" {
+		return defaultRestartTimeout, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("timeout must be a duration such as 90s: %w", err)
+	}
+	if timeout <= 0 || timeout > maxRestartTimeout {
+		return 0, fmt.Errorf("timeout must be between 0s and %s", maxRestartTimeout)
+	}
+	return timeout, nil
+}
+
+// siblingUIDs returns the UIDs of the pods sharing the labels and controller
+// of pod, so a replacement can later be told apart from pods that already existed.
+func siblingUIDs(ctx context.Context, client kubernetes.Interface, pod *v1.Pod, owner *metav1.OwnerReference) (map[types.UID]bool, error) {
+	pods, err := client.CoreV1().Pods(pod.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(pod.Labels).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	uids := map[types.UID]bool{pod.UID: true}
+	for _, sibling := range pods.Items {
+		if controller := metav1.GetControllerOf(&sibling); controller != nil && controller.UID == owner.UID {
+			uids[sibling.UID] = true
+		}
+	}
+	return uids, nil
+}
+
+// waitForReplacement polls until a pod owned by the same controller, not part
+// of existing, is Ready.
+func waitForReplacement(ctx context.Context, client kubernetes.Interface, pod *v1.Pod, owner *metav1.OwnerReference, existing map[types.UID]bool, timeout time.Duration) (*v1.Pod, error) {
+	var replacement *v1.Pod
+	err := wait.PollUntilContextTimeout(ctx, restartPollInterval, timeout, false, func(pollCtx context.Context) (bool, error) {
+		pods, err := client.CoreV1().Pods(pod.Namespace).List(pollCtx, metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(pod.Labels).String(),
+		})
+		if err != nil {
+			return false, err
+		}
+		for i := range pods.Items {
+			candidate := &pods.Items[i]
+			controller := metav1.GetControllerOf(candidate)
+			if existing[candidate.UID] || candidate.DeletionTimestamp != nil || controller == nil || controller.UID != owner.UID {
+				continue
+			}
+			if IsReady(candidate) {
+				replacement = candidate
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil && wait.Interrupted(err) {
+		return nil, apierrors.NewTimeoutError(fmt.Sprintf("timed out waiting for a Ready replacement of pod %q", pod.Name), 0)
+	}
+	return replacement, err
+}
+
+// IsReady reports whether the Ready condition of pod is true.
+func IsReady(pod *v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,292 @@
+package pod
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+var testOwner = metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-5d4f", UID: "rs-uid", Controller: ptr.To(true)}
+
+// newTestOwnedPod returns a pod controlled by owner with the given Ready condition.
+func newTestOwnedPod(name string, owner metav1.OwnerReference, ready bool) *v1.Pod {
+	pod := newTestPod(name)
+	pod.OwnerReferences = []metav1.OwnerReference{owner}
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+	pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: status}}
+	return pod
+}
+
+// rejectEviction answers evictions the way the apiserver does when a
+// PodDisruptionBudget does not allow the disruption.
+func rejectEviction(action k8stesting.Action) (bool, runtime.Object, error) {
+	if action.GetSubresource() != "eviction" {
+		return false, nil, nil
+	}
+	return true, nil, apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10)
+}
+
+func TestDeleteOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	background := metav1.DeletePropagationBackground
+	tests := []struct {
+		name    string
+		query   string
+		want    metav1.DeleteOptions
+		wantErr bool
+	}{
+		{name: "empty"},
+		{name: "grace period", query: "gracePeriodSeconds=0", want: metav1.DeleteOptions{GracePeriodSeconds: ptr.To[int64](0)}},
+		{name: "propagation policy", query: "propagationPolicy=Background", want: metav1.DeleteOptions{PropagationPolicy: &background}},
+		{name: "dry run", query: "dryRun=All", want: metav1.DeleteOptions{DryRun: []string{metav1.DryRunAll}}},
+		{name: "negative grace period", query: "gracePeriodSeconds=-1", wantErr: true},
+		{name: "invalid grace period", query: "gracePeriodSeconds=soon", wantErr: true},
+		{name: "invalid propagation policy", query: "propagationPolicy=Cascade", wantErr: true},
+		{name: "invalid dry run", query: "dryRun=true", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodDelete, "/?"+test.query, nil)
+			got, err := deleteOptions(ctx)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %t", err, test.wantErr)
+			}
+			if !test.wantErr && !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSiblingUIDs(t *testing.T) {
+	other := testOwner
+	other.UID = "other-rs-uid"
+	unlabelled := newTestOwnedPod("web-d", testOwner, true)
+	unlabelled.Labels = map[string]string{"app": "api"}
+	pod := newTestOwnedPod("web-a", testOwner, true)
+	client := fake.NewSimpleClientset(
+		pod,
+		newTestOwnedPod("web-b", testOwner, true),
+		newTestOwnedPod("web-c", other, true),
+		unlabelled,
+		newTestPod("web-e"),
+	)
+
+	got, err := siblingUIDs(context.Background(), client, pod, &testOwner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[types.UID]bool{"uid-web-a": true, "uid-web-b": true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestWaitForReplacement(t *testing.T) {
+	other := testOwner
+	other.UID = "other-rs-uid"
+	terminating := newTestOwnedPod("web-terminating", testOwner, true)
+	terminating.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	terminating.Finalizers = []string{"example.com/hold"}
+	tests := []struct {
+		name    string
+		pods    []*v1.Pod
+		timeout time.Duration
+		want    string
+	}{
+		{
+			name:    "ready replacement",
+			pods:    []*v1.Pod{newTestOwnedPod("web-b", testOwner, true), newTestOwnedPod("web-new", testOwner, true)},
+			timeout: 5 * time.Second,
+			want:    "web-new",
+		},
+		{
+			name: "timeout",
+			pods: []*v1.Pod{
+				// An existing sibling, a replacement that is not Ready yet, a
+				// terminating pod and a pod of another controller are no replacement.
+				newTestOwnedPod("web-b", testOwner, true),
+				newTestOwnedPod("web-new", testOwner, false),
+				terminating,
+				newTestOwnedPod("web-other", other, true),
+			},
+			timeout: 50 * time.Millisecond,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			for _, pod := range test.pods {
+				if err := client.Tracker().Add(pod); err != nil {
+					t.Fatal(err)
+				}
+			}
+			pod := newTestOwnedPod("web-a", testOwner, true)
+			existing := map[types.UID]bool{"uid-web-a": true, "uid-web-b": true}
+
+			replacement, err := waitForReplacement(context.Background(), client, pod, &testOwner, existing, test.timeout)
+			if test.want == "" {
+				if !apierrors.IsTimeout(err) {
+					t.Fatalf("got error %v, want a timeout", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if replacement == nil || replacement.Name != test.want {
+				t.Errorf("got replacement %v, want %s", replacement, test.want)
+			}
+		})
+	}
+}
+
+func TestEvictPod(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		budget   bool
+		wantCode int
+	}{
+		{name: "evict", path: "/namespaces/default/pods/web-a/evict", wantCode: http.StatusOK},
+		{name: "disruption budget", path: "/namespaces/default/pods/web-a/evict", budget: true, wantCode: http.StatusTooManyRequests},
+		{name: "invalid grace period", path: "/namespaces/default/pods/web-a/evict?gracePeriodSeconds=-1", wantCode: http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(newTestPod("web-a"))
+			if test.budget {
+				client.PrependReactor("create", "pods", rejectEviction)
+			}
+			router := newTestRouter(client)
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, test.path, nil))
+			if recorder.Code != test.wantCode {
+				t.Fatalf("got status %d, want %d: %s", recorder.Code, test.wantCode, recorder.Body.String())
+			}
+			if test.budget && recorder.Header().Get("Retry-After") != "10" {
+				t.Errorf("got Retry-After %q, want 10", recorder.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
+
+func TestRestartPod(t *testing.T) {
+	tests := []struct {
+		name     string
+		pod      *v1.Pod
+		query    string
+		budget   bool
+		wantCode int
+		wantVerb string
+	}{
+		{
+			name:     "delete",
+			pod:      newTestOwnedPod("web-a", testOwner, true),
+			query:    "wait=false",
+			wantCode: http.StatusOK,
+			wantVerb: "delete",
+		},
+		{
+			name:     "evict",
+			pod:      newTestOwnedPod("web-a", testOwner, true),
+			query:    "wait=false&evict=true",
+			wantCode: http.StatusOK,
+			wantVerb: "create",
+		},
+		{
+			name:     "eviction blocked by a disruption budget",
+			pod:      newTestOwnedPod("web-a", testOwner, true),
+			query:    "evict=true",
+			budget:   true,
+			wantCode: http.StatusTooManyRequests,
+		},
+		{
+			name:     "wait times out",
+			pod:      newTestOwnedPod("web-a", testOwner, true),
+			query:    "timeout=10ms",
+			wantCode: http.StatusGatewayTimeout,
+		},
+		{
+			name:     "pod without a controller",
+			pod:      newTestPod("web-a"),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "dry run",
+			pod:      newTestOwnedPod("web-a", testOwner, true),
+			query:    "dryRun=All",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid timeout",
+			pod:      newTestOwnedPod("web-a", testOwner, true),
+			query:    "timeout=1h",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(test.pod)
+			if test.budget {
+				client.PrependReactor("create", "pods", rejectEviction)
+			}
+			router := newTestRouter(client)
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/namespaces/default/pods/web-a/restart?"+test.query, nil))
+			if recorder.Code != test.wantCode {
+				t.Fatalf("got status %d, want %d: %s", recorder.Code, test.wantCode, recorder.Body.String())
+			}
+			if test.wantVerb == "" {
+				return
+			}
+			var response RestartPodResponse
+			if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+				t.Fatal(err)
+			}
+			if response.Controller != "ReplicaSet/web-5d4f" {
+				t.Errorf("got controller %q, want ReplicaSet/web-5d4f", response.Controller)
+			}
+			var options *metav1.DeleteOptions
+			for _, action := range client.Actions() {
+				switch action := action.(type) {
+				case k8stesting.DeleteAction:
+					if test.wantVerb == "delete" {
+						options = ptr.To(action.GetDeleteOptions())
+					}
+				case k8stesting.CreateAction:
+					if test.wantVerb == "create" && action.GetSubresource() == "eviction" {
+						options = action.GetObject().(*policyv1.Eviction).DeleteOptions
+					}
+				}
+			}
+			if options == nil {
+				t.Fatalf("the pod was not removed with %s", test.wantVerb)
+			}
+			if options.Preconditions == nil || options.Preconditions.UID == nil || *options.Preconditions.UID != "uid-web-a" {
+				t.Errorf("got preconditions %+v, want the UID of the pod", options.Preconditions)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package pod
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"time"
+)
+
+// maxLogLineSize bounds a single log line read from the apiserver.
+const maxLogLineSize = 1024 * 1024
+
+// LogQuery holds the query parameters accepted by the log endpoints.
+type LogQuery struct {
+	Container    string `form:"container"`
+	Follow       bool   `form:"follow"`
+	Previous     bool   `form:"previous"`
+	Timestamps   bool   `form:"timestamps"`
+	TailLines    *int64 `form:"tailLines"`
+	SinceSeconds *int64 `form:"sinceSeconds"`
+	SinceTime    string `form:"sinceTime"`
+	LimitBytes   *int64 `form:"limitBytes"`
+}
+
+// PodLogOptions validates q and converts it to the options understood by GetLogs.
+func (q LogQuery) PodLogOptions() (*v1.PodLogOptions, error) {
+	options := &v1.PodLogOptions{
+		Container:    q.Container,
+		Follow:       q.Follow,
+		Previous:     q.Previous,
+		Timestamps:   q.Timestamps,
+		TailLines:    q.TailLines,
+		SinceSeconds: q.SinceSeconds,
+		LimitBytes:   q.LimitBytes,
+	}
+	if q.TailLines != nil && *q.TailLines < 0 {
+		return nil, errors.New("tailLines must not be negative")
+	}
+	if q.SinceSeconds != nil && *q.SinceSeconds < 1 {
+		return nil, errors.New("sinceSeconds must be greater than 0")
+	}
+	if q.LimitBytes != nil && *q.LimitBytes < 1 {
+		return nil, errors.New("limitBytes must be greater than 0")
+	}
+	if q.SinceTime != "" {
+		if q.SinceSeconds != nil {
+			return nil, errors.New("only one of sinceSeconds or sinceTime may be specified")
+		}
+		sinceTime, err := time.Parse(time.RFC3339, q.SinceTime)
+		if err != nil {
+			return nil, fmt.Errorf("sinceTime must be an RFC3339 timestamp: %w", err)
+		}
+		options.SinceTime = &metav1.Time{Time: sinceTime}
+	}
+	return options, nil
+}
+
+// BindLogQuery reads the log query parameters of the request.
+func BindLogQuery(ctx *gin.Context) (LogQuery, error) {
+	var query LogQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		return query, err
+	}
+	return query, nil
+}
+
+// NewLogScanner returns a line scanner over r that accepts long log lines.
+func NewLogScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	return scanner
+}
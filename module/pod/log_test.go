@@ -0,0 +1,180 @@
+package pod
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestLogQueryPodLogOptions(t *testing.T) {
+	sinceTime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		query   string
+		want    *v1.PodLogOptions
+		wantErr string
+	}{
+		{
+			name:  "defaults",
+			query: "",
+			want:  &v1.PodLogOptions{},
+		},
+		{
+			name:  "all flags",
+			query: "container=app&follow=true&previous=true&timestamps=true&tailLines=10&sinceSeconds=60&limitBytes=1024",
+			want: &v1.PodLogOptions{
+				Container:    "app",
+				Follow:       true,
+				Previous:     true,
+				Timestamps:   true,
+				TailLines:    ptr.To[int64](10),
+				SinceSeconds: ptr.To[int64](60),
+				LimitBytes:   ptr.To[int64](1024),
+			},
+		},
+		{
+			name:  "zero tailLines",
+			query: "tailLines=0",
+			want:  &v1.PodLogOptions{TailLines: ptr.To[int64](0)},
+		},
+		{
+			name:  "sinceTime",
+			query: "sinceTime=2023-10-01T12:00:00Z",
+			want:  &v1.PodLogOptions{SinceTime: &metav1.Time{Time: sinceTime}},
+		},
+		{
+			name:    "negative tailLines",
+			query:   "tailLines=-1",
+			wantErr: "tailLines must not be negative",
+		},
+		{
+			name:    "zero sinceSeconds",
+			query:   "sinceSeconds=0",
+			wantErr: "sinceSeconds must be greater than 0",
+		},
+		{
+			name:    "zero limitBytes",
+			query:   "limitBytes=0",
+			wantErr: "limitBytes must be greater than 0",
+		},
+		{
+			name:    "sinceSeconds and sinceTime",
+			query:   "sinceSeconds=60&sinceTime=2023-10-01T12:00:00Z",
+			wantErr: "only one of sinceSeconds or sinceTime may be specified",
+		},
+		{
+			name:    "invalid sinceTime",
+			query:   "sinceTime=yesterday",
+			wantErr: "sinceTime must be an RFC3339 timestamp",
+		},
+		{
+			name:    "invalid follow",
+			query:   "follow=maybe",
+			wantErr: "invalid syntax",
+		},
+		{
+			name:    "invalid tailLines",
+			query:   "tailLines=ten",
+			wantErr: "invalid syntax",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/log?"+test.query, nil)
+			query, err := BindLogQuery(ctx)
+			var options *v1.PodLogOptions
+			if err == nil {
+				options, err = query.PodLogOptions()
+			}
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("got error %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(options, test.want) {
+				t.Errorf("got %+v, want %+v", options, test.want)
+			}
+		})
+	}
+}
+
+func TestGetPodLog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	client := fake.NewSimpleClientset(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}})
+	controller := NewPodController(&K8sClient{Client: client})
+	route := NewPodRoute(controller)
+	router := gin.New()
+	route.Route(router.Group("/namespaces/:namespace/pods"))
+
+	tests := []struct {
+		name        string
+		path        string
+		accept      string
+		wantCode    int
+		wantType    string
+		wantContain string
+	}{
+		{
+			name:        "plain text",
+			path:        "/namespaces/default/pods/web/log",
+			wantCode:    http.StatusOK,
+			wantType:    "text/plain",
+			wantContain: "fake logs\n",
+		},
+		{
+			name:        "server-sent events",
+			path:        "/namespaces/default/pods/web/log?format=sse",
+			wantCode:    http.StatusOK,
+			wantType:    "text/event-stream",
+			wantContain: "event:log\ndata:fake logs\n",
+		},
+		{
+			name:        "server-sent events by accept header",
+			path:        "/namespaces/default/pods/web/log",
+			accept:      "text/event-stream",
+			wantCode:    http.StatusOK,
+			wantType:    "text/event-stream",
+			wantContain: "event:end",
+		},
+		{
+			name:        "invalid query",
+			path:        "/namespaces/default/pods/web/log?tailLines=-1",
+			wantCode:    http.StatusBadRequest,
+			wantType:    "application/json",
+			wantContain: "tailLines must not be negative",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, test.path, nil)
+			if test.accept != "" {
+				request.Header.Set("Accept", test.accept)
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+			if recorder.Code != test.wantCode {
+				t.Fatalf("got status %d, want %d: %s", recorder.Code, test.wantCode, recorder.Body.String())
+			}
+			if contentType := recorder.Header().Get("Content-Type"); !strings.HasPrefix(contentType, test.wantType) {
+				t.Errorf("got Content-Type %q, want %q", contentType, test.wantType)
+			}
+			if !strings.Contains(recorder.Body.String(), test.wantContain) {
+				t.Errorf("body %q does not contain %q", recorder.Body.String(), test.wantContain)
+			}
+		})
+	}
+}
@@ -10,7 +10,20 @@ func NewPodRoute(controller Controller) Route {
 	return Route{controller}
 }
 
+// AllNamespacesRoute mounts the cross-namespace pod list, e.g. on /api/v1/pods.
+func (r *Route) AllNamespacesRoute(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListAllPod)
+}
+
 func (r *Route) Route(router *gin.RouterGroup) {
 	router.GET("", r.controller.ListPod)
 	router.GET(":podName", r.controller.GetPod)
+	router.PATCH(":podName", r.controller.PatchPod)
+	router.DELETE(":podName", r.controller.DeletePod)
+	router.POST(":podName/evict", r.controller.EvictPod)
+	router.POST(":podName/restart", r.controller.RestartPod)
+	router.GET(":podName/diagnose", r.controller.DiagnosePod)
+	router.GET(":podName/log", r.controller.GetPodLog)
+	router.GET(":podName/exec", r.controller.ExecPod)
+	router.POST(":podName/exec", r.controller.RunPodCommand)
 }
@@ -0,0 +1,442 @@
+package secret
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	"github.com/jobayer12/go-kubernetes/module/common"
+	"github.com/jobayer12/go-kubernetes/module/pod"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	EncodingUTF8   = "utf-8"
+	EncodingBase64 = "base64"
+)
+
+var (
+	secretsResource   = v1.Resource("secrets")
+	errRevealDisabled = errors.New("revealing secret values is disabled on this server")
+	errRevealToken    = fmt.Errorf("reveal=true requires a valid %s header", RevealHeader)
+)
+
+// SecretManifest documents the Secret accepted by create and replace.
+type SecretManifest struct {
+	v1.Secret `json:",inline"`
+}
+
+type KeyResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	// Encoding is base64 for values that are not valid UTF-8.
+	Encoding string `json:"encoding"`
+}
+
+type PutKeyRequest struct {
+	Value *string `json:"value" binding:"required"`
+	// Encoding base64 means value is already base64 encoded, e.g. binary data.
+	Encoding string `json:"encoding" enums:"utf-8,base64"`
+}
+
+type K8sClient struct {
+	Client kubernetes.Interface
+	Config *rest.Config
+	Cache  *cluster.Cache
+}
+
+type Controller struct {
+	*K8sClient
+	// revealToken authorizes reveal=true, which is disabled when empty.
+	revealToken string
+}
+
+func NewSecretController(k8sClient *K8sClient, revealToken string) Controller {
+	return Controller{K8sClient: k8sClient, revealToken: revealToken}
+}
+
+// client returns the clientset of the cluster the request targets.
+func (k *K8sClient) client(ctx *gin.Context) kubernetes.Interface {
+	return cluster.Client(ctx, k.Client)
+}
+
+// cache returns the read cache of the cluster the request targets.
+func (k *K8sClient) cache(ctx *gin.Context) *cluster.Cache {
+	return cluster.CacheFor(ctx, k.Cache)
+}
+
+// ListSecret
+// @Summary			Get the List of secret.
+// @Description		Return list of secret. Values are redacted to key names, sizes and SHA-256 hashes.
+// @Tags			secret
+// @Router			/api/v1/namespaces/{namespace}/secrets [get]
+// @Param 			namespace path string true "Namespace, _all lists every namespace" default(default)
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. type=kubernetes.io/tls"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} RedactedSecretList
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (sc *Controller) ListSecret(ctx *gin.Context) {
+	sc.listSecrets(ctx, common.NamespaceParam(ctx))
+}
+
+// ListAllSecret
+// @Summary			Get the List of secret in all namespaces.
+// @Description		Return list of secret across every namespace. Values are redacted to key names, sizes and SHA-256 hashes.
+// @Tags			secret
+// @Router			/api/v1/secrets [get]
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. type=kubernetes.io/tls"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} RedactedSecretList
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (sc *Controller) ListAllSecret(ctx *gin.Context) {
+	sc.listSecrets(ctx, metav1.NamespaceAll)
+}
+
+func (sc *Controller) listSecrets(ctx *gin.Context, namespace string) {
+	query, err := common.ParseListQuery(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	watch, err := common.WantsWatch(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	secrets := sc.client(ctx).CoreV1().Secrets(namespace)
+	if watch {
+		common.StreamWatch(ctx, common.ListWatch{
+			List: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				return secrets.List(ctx, options)
+			},
+			Watch:     secrets.Watch,
+			Transform: redactObject,
+		}, query.WatchOptions())
+		return
+	}
+	list, err := secrets.List(ctx.Request.Context(), query.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, RedactList(list))
+}
+
+// GetSecret
+// @Summary			Get secret by name.
+// @Description		Return the secret with its values redacted. With reveal=true and a valid X-Secret-Reveal-Token header the Secret is returned as stored; every reveal attempt is audited.
+// @Tags			secret
+// @Router			/api/v1/namespaces/{namespace}/secrets/{name} [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Secret name"
+// @Param 			reveal query bool false "Return the secret values"
+// @Param 			X-Secret-Reveal-Token header string false "Token authorizing reveal=true"
+// @Response		200 {object} RedactedSecret "Redacted secret, or the Secret itself with reveal=true"
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (sc *Controller) GetSecret(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	reveal, err := wantsReveal(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if reveal {
+		if err := sc.authorizeReveal(ctx, namespace, name, ""); err != nil {
+			common.Error(ctx, err)
+			return
+		}
+	}
+	secret, err := sc.client(ctx).CoreV1().Secrets(namespace).Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	if reveal {
+		ctx.JSON(http.StatusOK, secret)
+		return
+	}
+	ctx.JSON(http.StatusOK, Redact(secret))
+}
+
+// CreateSecret
+// @Summary			Create secret
+// @Description		Create a secret from a JSON or YAML manifest, with data or stringData. The response is redacted. Use dryRun=All to validate without persisting.
+// @Tags			secret
+// @Router			/api/v1/namespaces/{namespace}/secrets [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			request body SecretManifest true "Secret manifest"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		201 {object} RedactedSecret
+// @Failure			400,401,403,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json,application/yaml
+// @Produce			application/json
+func (sc *Controller) CreateSecret(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	secret, dryRun, err := decodeSecret(ctx, namespace, "")
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := sc.client(ctx).CoreV1().Secrets(namespace).Create(ctx.Request.Context(), secret, metav1.CreateOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, Redact(result))
+}
+
+// ReplaceSecret
+// @Summary			Replace secret
+// @Description		Replace a secret with a JSON or YAML manifest. Set metadata.resourceVersion to guard against concurrent updates. The response is redacted.
+// @Tags			secret
+// @Router			/api/v1/namespaces/{namespace}/secrets/{name} [put]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Secret name"
+// @Param 			request body SecretManifest true "Secret manifest"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} RedactedSecret
+// @Failure			400,401,403,404,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json,application/yaml
+// @Produce			application/json
+func (sc *Controller) ReplaceSecret(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	secret, dryRun, err := decodeSecret(ctx, namespace, name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := sc.client(ctx).CoreV1().Secrets(namespace).Update(ctx.Request.Context(), secret, metav1.UpdateOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, Redact(result))
+}
+
+// decodeSecret decodes the Secret manifest of a create or replace request.
+func decodeSecret(ctx *gin.Context, namespace, name string) (*v1.Secret, []string, error) {
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		return nil, nil, apierrors.NewBadRequest(err.Error())
+	}
+	obj, gvk, err := common.DecodeManifest(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	objectMeta, _ := meta.Accessor(obj)
+	if err := common.ValidateManifestMeta(gvk, v1.SchemeGroupVersion.WithKind("Secret"), objectMeta, namespace, name); err != nil {
+		return nil, nil, err
+	}
+	secret := obj.(*v1.Secret)
+	secret.Namespace = namespace
+	return secret, dryRun, nil
+}
+
+// PatchSecret
+// @Summary			Patch secret
+// @Description		Apply a JSON patch, merge patch, strategic merge patch or server-side apply patch, selected by the Content-Type. The response is redacted.
+// @Tags			secret
+// @Router			/api/v1/namespaces/{namespace}/secrets/{name} [patch]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Secret name"
+// @Param 			request body object true "Patch document"
+// @Param 			fieldManager query string false "Field manager recorded for the change, required for apply patches" default(go-kubernetes)
+// @Param 			force query bool false "Force an apply patch, taking ownership of conflicting fields"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} RedactedSecret
+// @Failure			400,401,403,404,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json-patch+json,application/merge-patch+json,application/strategic-merge-patch+json,application/apply-patch+yaml
+// @Produce			application/json
+func (sc *Controller) PatchSecret(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	patch, err := common.DecodePatch(ctx)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := sc.client(ctx).CoreV1().Secrets(namespace).Patch(ctx.Request.Context(), name, patch.Type, patch.Data, patch.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, Redact(result))
+}
+
+// DeleteSecret
+// @Summary			Delete secret
+// @Tags			secret
+// @Router			/api/v1/namespaces/{namespace}/secrets/{name} [delete]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Secret name"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @response     	default {boolean}  boolean true
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (sc *Controller) DeleteSecret(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	err = sc.client(ctx).CoreV1().Secrets(namespace).Delete(ctx.Request.Context(), name, metav1.DeleteOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, true)
+}
+
+// GetSecretKey
+// @Summary			Get secret key
+// @Description		Return the size and SHA-256 hash of a single key. With reveal=true and a valid X-Secret-Reveal-Token header the value is returned, as UTF-8 text when possible and base64 otherwise; every reveal attempt is audited.
+// @Tags			secret
+// @Router			/api/v1/namespaces/{namespace}/secrets/{name}/keys/{key} [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Secret name"
+// @Param 			key path string true "Key"
+// @Param 			reveal query bool false "Return the value"
+// @Param 			X-Secret-Reveal-Token header string false "Token authorizing reveal=true"
+// @Response		200 {object} RedactedKey "Redacted key, or KeyResponse with reveal=true"
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (sc *Controller) GetSecretKey(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	key := ctx.Param("key")
+	reveal, err := wantsReveal(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if reveal {
+		if err := sc.authorizeReveal(ctx, namespace, name, key); err != nil {
+			common.Error(ctx, err)
+			return
+		}
+	}
+	secret, err := sc.client(ctx).CoreV1().Secrets(namespace).Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		common.Error(ctx, apierrors.NewNotFound(v1.Resource("secrets/keys"), name+"/"+key))
+		return
+	}
+	if !reveal {
+		ctx.JSON(http.StatusOK, redactKey(key, value))
+		return
+	}
+	if utf8.Valid(value) {
+		ctx.JSON(http.StatusOK, KeyResponse{Key: key, Value: string(value), Encoding: EncodingUTF8})
+		return
+	}
+	ctx.JSON(http.StatusOK, KeyResponse{Key: key, Value: base64.StdEncoding.EncodeToString(value), Encoding: EncodingBase64})
+}
+
+// PutSecretKey
+// @Summary			Set secret key
+// @Description		Create or replace a single key, leaving the other keys untouched. The response is redacted.
+// @Tags			secret
+// @Router			/api/v1/namespaces/{namespace}/secrets/{name}/keys/{key} [put]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Secret name"
+// @Param 			key path string true "Key"
+// @Param 			request body PutKeyRequest true "Value"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} RedactedKey
+// @Failure			400,401,403,404,409,422,500 {object} common.ErrorResponse
+// @Accept			application/json
+// @Produce			application/json
+func (sc *Controller) PutSecretKey(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	key := ctx.Param("key")
+	var request PutKeyRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	if err := common.ValidateDataKey(v1.SchemeGroupVersion.WithKind("Secret").GroupKind(), name, key); err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	var value []byte
+	switch request.Encoding {
+	case "", EncodingUTF8:
+		value = []byte(*request.Value)
+	case EncodingBase64:
+		if value, err = base64.StdEncoding.DecodeString(*request.Value); err != nil {
+			common.BadRequest(ctx, fmt.Errorf("value is not valid base64: %w", err))
+			return
+		}
+	default:
+		common.BadRequest(ctx, fmt.Errorf("encoding must be %s or %s, got %q", EncodingUTF8, EncodingBase64, request.Encoding))
+		return
+	}
+	// []byte values are marshalled as base64, the encoding of Secret data.
+	data, err := json.Marshal(map[string]any{"data": map[string][]byte{key: value}})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	secret, err := sc.client(ctx).CoreV1().Secrets(namespace).Patch(ctx.Request.Context(), name, types.MergePatchType, data, metav1.PatchOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, redactKey(key, secret.Data[key]))
+}
+
+// GetSecretConsumers
+// @Summary			Get secret consumers
+// @Description		Return the deployments and pods that reference the secret through volumes, envFrom, env or imagePullSecrets, i.e. what to restart after changing it.
+// @Tags			secret
+// @Router			/api/v1/namespaces/{namespace}/secrets/{name}/consumers [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Secret name"
+// @Response		200 {object} pod.ConsumersResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (sc *Controller) GetSecretConsumers(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	consumers, err := pod.FindConsumers(ctx.Request.Context(), sc.client(ctx), sc.cache(ctx), namespace, "Secret", name)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, consumers)
+}
@@ -0,0 +1,111 @@
+package secret
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetSecretReveal(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name        string
+		revealToken string
+		path        string
+		header      string
+		wantCode    int
+		wantContain string
+	}{
+		{
+			name:        "redacted by default",
+			revealToken: "token",
+			path:        "/namespaces/default/secrets/db",
+			wantCode:    http.StatusOK,
+			wantContain: `"keys":[`,
+		},
+		{
+			name:        "reveal disabled",
+			path:        "/namespaces/default/secrets/db?reveal=true",
+			header:      "token",
+			wantCode:    http.StatusForbidden,
+			wantContain: "disabled",
+		},
+		{
+			name:        "reveal without token",
+			revealToken: "token",
+			path:        "/namespaces/default/secrets/db?reveal=true",
+			wantCode:    http.StatusForbidden,
+			wantContain: RevealHeader,
+		},
+		{
+			name:        "reveal with wrong token",
+			revealToken: "token",
+			path:        "/namespaces/default/secrets/db?reveal=true",
+			header:      "guess",
+			wantCode:    http.StatusForbidden,
+			wantContain: RevealHeader,
+		},
+		{
+			name:        "reveal",
+			revealToken: "token",
+			path:        "/namespaces/default/secrets/db?reveal=true",
+			header:      "token",
+			wantCode:    http.StatusOK,
+			wantContain: `"password":"aHVudGVyMg=="`,
+		},
+		{
+			name:        "key redacted by default",
+			revealToken: "token",
+			path:        "/namespaces/default/secrets/db/keys/password",
+			wantCode:    http.StatusOK,
+			wantContain: `"size":7`,
+		},
+		{
+			name:        "reveal key",
+			revealToken: "token",
+			path:        "/namespaces/default/secrets/db/keys/password?reveal=true",
+			header:      "token",
+			wantCode:    http.StatusOK,
+			wantContain: `"value":"hunter2"`,
+		},
+		{
+			name:        "invalid reveal",
+			revealToken: "token",
+			path:        "/namespaces/default/secrets/db?reveal=yes",
+			wantCode:    http.StatusBadRequest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(newTestSecret())
+			route := NewSecretRoute(NewSecretController(&K8sClient{Client: client}, test.revealToken))
+			router := gin.New()
+			route.Route(router.Group("/namespaces/:namespace/secrets"))
+
+			request := httptest.NewRequest(http.MethodGet, test.path, nil)
+			if test.header != "" {
+				request.Header.Set(RevealHeader, test.header)
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+			body := recorder.Body.String()
+			if recorder.Code != test.wantCode {
+				t.Fatalf("got status %d, want %d: %s", recorder.Code, test.wantCode, body)
+			}
+			if !strings.Contains(body, test.wantContain) {
+				t.Errorf("body %s does not contain %q", body, test.wantContain)
+			}
+			if test.wantCode != http.StatusOK || test.header == "" {
+				for _, value := range []string{"hunter2", "aHVudGVyMg=="} {
+					if strings.Contains(body, value) {
+						t.Errorf("body %s leaks %q", body, value)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package secret
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sort"
+)
+
+// lastAppliedAnnotation is written by kubectl apply and holds the whole
+// manifest, values included.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// RedactedKey describes a secret value without disclosing it.
+type RedactedKey struct {
+	Name string `json:"name"`
+	// Size is the length of the decoded value in bytes.
+	Size int `json:"size"`
+	// SHA256 is the hex encoded hash of the decoded value, to compare values
+	// across secrets and clusters.
+	SHA256 string `json:"sha256"`
+}
+
+// RedactedSecret is a Secret with its data replaced by key descriptions.
+type RedactedSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Type              v1.SecretType `json:"type,omitempty"`
+	Immutable         *bool         `json:"immutable,omitempty"`
+	Keys              []RedactedKey `json:"keys"`
+}
+
+type RedactedSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []RedactedSecret `json:"items"`
+}
+
+func redactKey(name string, value []byte) RedactedKey {
+	sum := sha256.Sum256(value)
+	return RedactedKey{Name: name, Size: len(value), SHA256: hex.EncodeToString(sum[:])}
+}
+
+// Redact returns secret without its values, sorted by key name.
+func Redact(secret *v1.Secret) RedactedSecret {
+	objectMeta := *secret.ObjectMeta.DeepCopy()
+	delete(objectMeta.Annotations, lastAppliedAnnotation)
+	keys := make([]RedactedKey, 0, len(secret.Data))
+	for name, value := range secret.Data {
+		keys = append(keys, redactKey(name, value))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+	return RedactedSecret{
+		TypeMeta:   secret.TypeMeta,
+		ObjectMeta: objectMeta,
+		Type:       secret.Type,
+		Immutable:  secret.Immutable,
+		Keys:       keys,
+	}
+}
+
+func RedactList(list *v1.SecretList) RedactedSecretList {
+	items := make([]RedactedSecret, 0, len(list.Items))
+	for i := range list.Items {
+		items = append(items, Redact(&list.Items[i]))
+	}
+	return RedactedSecretList{TypeMeta: list.TypeMeta, ListMeta: list.ListMeta, Items: items}
+}
+
+// redactObject redacts the secrets sent by a watch stream.
+func redactObject(obj runtime.Object) any {
+	switch obj := obj.(type) {
+	case *v1.Secret:
+		return Redact(obj)
+	case *v1.SecretList:
+		return RedactList(obj)
+	}
+	return obj
+}
@@ -0,0 +1,76 @@
+package secret
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestRedact(t *testing.T) {
+	secret := newTestSecret()
+	redacted := Redact(secret)
+
+	want := []RedactedKey{
+		{Name: "empty", Size: 0, SHA256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{Name: "password", Size: 7, SHA256: "f52fbd32b2b3b86ff88ef6c490628285f482af15ddcb29541f94bcf526a3f6c7"},
+		{Name: "username", Size: 5, SHA256: "8c6976e5b5410415bde908bd4dee15dfb167a9c873fc4bb8a81f6f2ab448a918"},
+	}
+	if !reflect.DeepEqual(redacted.Keys, want) {
+		t.Errorf("got keys %+v, want %+v", redacted.Keys, want)
+	}
+	if _, ok := redacted.Annotations[lastAppliedAnnotation]; ok {
+		t.Errorf("the %s annotation was not removed", lastAppliedAnnotation)
+	}
+	if redacted.Annotations["owner"] != "team-a" || redacted.Labels["app"] != "web" || redacted.Type != v1.SecretTypeOpaque {
+		t.Errorf("metadata was not preserved: %+v", redacted)
+	}
+	if _, ok := secret.Annotations[lastAppliedAnnotation]; !ok {
+		t.Error("the source secret was modified")
+	}
+
+	data, err := json.Marshal(redacted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range []string{"hunter2", "aHVudGVyMg==", "admin", "YWRtaW4="} {
+		if strings.Contains(string(data), value) {
+			t.Errorf("redacted secret %s contains %q", data, value)
+		}
+	}
+}
+
+func TestRedactWithoutData(t *testing.T) {
+	redacted := Redact(&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "empty"}})
+	data, err := json.Marshal(redacted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"keys":[]`) {
+		t.Errorf("got %s, want an empty keys list", data)
+	}
+}
+
+func TestRedactObject(t *testing.T) {
+	list := &v1.SecretList{Items: []v1.Secret{*newTestSecret()}}
+	tests := []struct {
+		name string
+		obj  runtime.Object
+		want any
+	}{
+		{name: "secret", obj: newTestSecret(), want: Redact(newTestSecret())},
+		{name: "list", obj: list, want: RedactList(list)},
+		{name: "other", obj: &metav1.Status{Message: "gone"}, want: &metav1.Status{Message: "gone"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := redactObject(test.obj); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
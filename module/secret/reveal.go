@@ -0,0 +1,45 @@
+package secret
+
+import (
+	"crypto/subtle"
+	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"log"
+	"strconv"
+)
+
+// RevealHeader carries the token authorizing reveal=true.
+const RevealHeader = "X-Secret-Reveal-Token"
+
+// wantsReveal reports whether a request asked for ?reveal=true.
+func wantsReveal(ctx *gin.Context) (bool, error) {
+	value := ctx.Query("reveal")
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// authorizeReveal checks the reveal token of the request and writes an audit
+// log line for every attempt, allowed or not.
+func (sc *Controller) authorizeReveal(ctx *gin.Context, namespace, name, key string) error {
+	token := ctx.GetHeader(RevealHeader)
+	allowed := sc.revealToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(sc.revealToken)) == 1
+
+	clusterName := "(default)"
+	if c, ok := cluster.FromContext(ctx); ok {
+		clusterName = c.Name
+	}
+	log.Printf("audit: secret reveal cluster=%q namespace=%q name=%q key=%q client=%s allowed=%t",
+		clusterName, namespace, name, key, ctx.ClientIP(), allowed)
+
+	switch {
+	case allowed:
+		return nil
+	case sc.revealToken == "":
+		return apierrors.NewForbidden(secretsResource, name, errRevealDisabled)
+	default:
+		return apierrors.NewForbidden(secretsResource, name, errRevealToken)
+	}
+}
@@ -0,0 +1,28 @@
+package secret
+
+import "github.com/gin-gonic/gin"
+
+type Route struct {
+	controller Controller
+}
+
+func NewSecretRoute(controller Controller) Route {
+	return Route{controller}
+}
+
+// AllNamespacesRoute mounts the cross-namespace secret list, e.g. on /api/v1/secrets.
+func (r *Route) AllNamespacesRoute(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListAllSecret)
+}
+
+func (r *Route) Route(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListSecret)
+	router.POST("", r.controller.CreateSecret)
+	router.GET(":name", r.controller.GetSecret)
+	router.PUT(":name", r.controller.ReplaceSecret)
+	router.PATCH(":name", r.controller.PatchSecret)
+	router.DELETE(":name", r.controller.DeleteSecret)
+	router.GET(":name/keys/:key", r.controller.GetSecretKey)
+	router.PUT(":name/keys/:key", r.controller.PutSecretKey)
+	router.GET(":name/consumers", r.controller.GetSecretConsumers)
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	"github.com/jobayer12/go-kubernetes/module/common"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"net/http"
+)
+
+type ListServiceResponse struct {
+	v1.ServiceList `json:",inline"`
+}
+
+type GetServiceResponse struct {
+	v1.Service `json:",inline"`
+}
+
+type K8sClient struct {
+	Client kubernetes.Interface
+	Config *rest.Config
+	Cache  *cluster.Cache
+}
+
+type Controller struct {
+	*K8sClient
+}
+
+func NewServiceController(k8sClient *K8sClient) Controller {
+	return Controller{K8sClient: k8sClient}
+}
+
+// client returns the clientset of the cluster the request targets.
+func (k *K8sClient) client(ctx *gin.Context) kubernetes.Interface {
+	return cluster.Client(ctx, k.Client)
+}
+
+// cache returns the read cache of the cluster the request targets.
+func (k *K8sClient) cache(ctx *gin.Context) *cluster.Cache {
+	return cluster.CacheFor(ctx, k.Cache)
+}
+
+// ListService
+// @Summary			Get the List of service.
+// @Description		Return list of service.
+// @Tags			service
+// @Router			/api/v1/namespaces/{namespace}/services [get]
+// @Param 			namespace path string true "Namespace, _all lists every namespace" default(default)
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. spec.type=LoadBalancer"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} ListServiceResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (sc *Controller) ListService(ctx *gin.Context) {
+	sc.listServices(ctx, common.NamespaceParam(ctx))
+}
+
+// ListAllService
+// @Summary			Get the List of service in all namespaces.
+// @Description		Return list of service across every namespace.
+// @Tags			service
+// @Router			/api/v1/services [get]
+// @Param 			labelSelector query string false "Label selector, e.g. app=web,tier!=cache"
+// @Param 			fieldSelector query string false "Field selector, e.g. spec.type=LoadBalancer"
+// @Param 			limit query int false "Maximum number of items to return; use metadata.continue of the response to fetch the next page"
+// @Param 			continue query string false "Continue token returned by the previous page"
+// @Param 			watch query bool false "Stream SYNC, ADDED, MODIFIED and DELETED events as Server-Sent Events"
+// @Response		200 {object} ListServiceResponse
+// @Failure			400,401,403,500 {object} common.ErrorResponse
+// @Produce			application/json,text/event-stream
+func (sc *Controller) ListAllService(ctx *gin.Context) {
+	sc.listServices(ctx, metav1.NamespaceAll)
+}
+
+func (sc *Controller) listServices(ctx *gin.Context, namespace string) {
+	query, err := common.ParseListQuery(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	watch, err := common.WantsWatch(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	services := sc.client(ctx).CoreV1().Services(namespace)
+	if watch {
+		common.StreamWatch(ctx, common.ListWatch{
+			List: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				return services.List(ctx, options)
+			},
+			Watch: services.Watch,
+		}, query.WatchOptions())
+		return
+	}
+	list, err := services.List(ctx.Request.Context(), query.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, list)
+}
+
+// GetService
+// @Summary			Get service by name.
+// @Description		Return service.
+// @Tags			service
+// @Router			/api/v1/namespaces/{namespace}/services/{name} [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Service name"
+// @Response		200 {object} GetServiceResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (sc *Controller) GetService(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	service, err := sc.client(ctx).CoreV1().Services(namespace).Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, service)
+}
+
+// CreateService
+// @Summary			Create service
+// @Description		Create a service from a JSON or YAML manifest. Use dryRun=All to validate without persisting.
+// @Tags			service
+// @Router			/api/v1/namespaces/{namespace}/services [post]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			request body GetServiceResponse true "Service manifest"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		201 {object} GetServiceResponse
+// @Failure			400,401,403,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json,application/yaml
+// @Produce			application/json
+func (sc *Controller) CreateService(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	service, dryRun, err := decodeService(ctx, namespace)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := sc.client(ctx).CoreV1().Services(namespace).Create(ctx.Request.Context(), service, metav1.CreateOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, result)
+}
+
+// decodeService decodes the service manifest of a create request.
+func decodeService(ctx *gin.Context, namespace string) (*v1.Service, []string, error) {
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		return nil, nil, apierrors.NewBadRequest(err.Error())
+	}
+	obj, gvk, err := common.DecodeManifest(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	objectMeta, _ := meta.Accessor(obj)
+	if err := common.ValidateManifestMeta(gvk, v1.SchemeGroupVersion.WithKind("Service"), objectMeta, namespace, ""); err != nil {
+		return nil, nil, err
+	}
+	service := obj.(*v1.Service)
+	service.Namespace = namespace
+	return service, dryRun, nil
+}
+
+// PatchService
+// @Summary			Patch service
+// @Description		Apply a JSON patch, merge patch, strategic merge patch or server-side apply patch, selected by the Content-Type.
+// @Tags			service
+// @Router			/api/v1/namespaces/{namespace}/services/{name} [patch]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Service name"
+// @Param 			request body object true "Patch document"
+// @Param 			fieldManager query string false "Field manager recorded for the change, required for apply patches" default(go-kubernetes)
+// @Param 			force query bool false "Force an apply patch, taking ownership of conflicting fields"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @Response		200 {object} GetServiceResponse
+// @Failure			400,401,403,404,409,415,422,500 {object} common.ErrorResponse
+// @Accept			application/json-patch+json,application/merge-patch+json,application/strategic-merge-patch+json,application/apply-patch+yaml
+// @Produce			application/json
+func (sc *Controller) PatchService(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	patch, err := common.DecodePatch(ctx)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	result, err := sc.client(ctx).CoreV1().Services(namespace).Patch(ctx.Request.Context(), name, patch.Type, patch.Data, patch.Options)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// DeleteService
+// @Summary			Delete service
+// @Tags			service
+// @Router			/api/v1/namespaces/{namespace}/services/{name} [delete]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Service name"
+// @Param 			dryRun query string false "Set to All to run the request without persisting it" Enums(All)
+// @response     	default {boolean}  boolean true
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (sc *Controller) DeleteService(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	dryRun, err := common.DryRun(ctx)
+	if err != nil {
+		common.BadRequest(ctx, err)
+		return
+	}
+	err = sc.client(ctx).CoreV1().Services(namespace).Delete(ctx.Request.Context(), name, metav1.DeleteOptions{DryRun: dryRun})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, true)
+}
+
+// GetServiceEndpoints
+// @Summary			Get the endpoints of a service.
+// @Description		Return the ready and not-ready pod addresses backing a service, read from its EndpointSlices, together with the pods its selector matches. Findings flag common causes of 503s such as a selector matching no pods or no ready endpoints.
+// @Tags			service
+// @Router			/api/v1/namespaces/{namespace}/services/{name}/endpoints [get]
+// @Param 			namespace path string true "Namespace" default(default)
+// @Param 			name path string true "Service name"
+// @Response		200 {object} ServiceEndpointsResponse
+// @Failure			400,401,403,404,500 {object} common.ErrorResponse
+// @Produce			application/json
+func (sc *Controller) GetServiceEndpoints(ctx *gin.Context) {
+	namespace := ctx.Param("namespace")
+	name := ctx.Param("name")
+	client := sc.client(ctx)
+	service, err := client.CoreV1().Services(namespace).Get(ctx.Request.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	response, err := serviceEndpoints(ctx.Request.Context(), client, sc.cache(ctx), service)
+	if err != nil {
+		common.Error(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCreateService(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		body        string
+		wantCode    int
+		wantContain string
+	}{
+		{
+			name:        "json",
+			path:        "/namespaces/default/services",
+			contentType: "application/json",
+			body:        `{"apiVersion":"v1","kind":"Service","metadata":{"name":"web"},"spec":{"ports":[{"port":80}]}}`,
+			wantCode:    http.StatusCreated,
+			wantContain: `"namespace":"default"`,
+		},
+		{
+			name:        "yaml",
+			path:        "/namespaces/default/services",
+			contentType: "application/yaml",
+			body:        "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n  namespace: default\nspec:\n  ports:\n  - port: 80\n",
+			wantCode:    http.StatusCreated,
+			wantContain: `"name":"web"`,
+		},
+		{
+			name:        "dry run",
+			path:        "/namespaces/default/services?dryRun=All",
+			contentType: "application/json",
+			body:        `{"apiVersion":"v1","kind":"Service","metadata":{"name":"web"}}`,
+			wantCode:    http.StatusCreated,
+		},
+		{
+			name:        "invalid dry run",
+			path:        "/namespaces/default/services?dryRun=true",
+			contentType: "application/json",
+			body:        `{"apiVersion":"v1","kind":"Service","metadata":{"name":"web"}}`,
+			wantCode:    http.StatusBadRequest,
+		},
+		{
+			name:        "unsupported media type",
+			path:        "/namespaces/default/services",
+			contentType: "text/plain",
+			body:        "web",
+			wantCode:    http.StatusUnsupportedMediaType,
+		},
+		{
+			name:        "malformed manifest",
+			path:        "/namespaces/default/services",
+			contentType: "application/json",
+			body:        `{"apiVersion":`,
+			wantCode:    http.StatusBadRequest,
+		},
+		{
+			name:        "wrong kind",
+			path:        "/namespaces/default/services",
+			contentType: "application/json",
+			body:        `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"web"}}`,
+			wantCode:    http.StatusUnprocessableEntity,
+			wantContain: "must be Service",
+		},
+		{
+			name:        "namespace mismatch",
+			path:        "/namespaces/default/services",
+			contentType: "application/json",
+			body:        `{"apiVersion":"v1","kind":"Service","metadata":{"name":"web","namespace":"prod"}}`,
+			wantCode:    http.StatusUnprocessableEntity,
+			wantContain: "metadata.namespace",
+		},
+		{
+			name:        "missing name",
+			path:        "/namespaces/default/services",
+			contentType: "application/json",
+			body:        `{"apiVersion":"v1","kind":"Service","metadata":{}}`,
+			wantCode:    http.StatusUnprocessableEntity,
+			wantContain: "metadata.name",
+		},
+		{
+			name:        "already exists",
+			path:        "/namespaces/default/services",
+			contentType: "application/json",
+			body:        `{"apiVersion":"v1","kind":"Service","metadata":{"name":"existing"}}`,
+			wantCode:    http.StatusConflict,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			existing := newTestService()
+			existing.Name = "existing"
+			router := newTestRouter(fake.NewSimpleClientset(existing))
+
+			request := httptest.NewRequest(http.MethodPost, test.path, strings.NewReader(test.body))
+			request.Header.Set("Content-Type", test.contentType)
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+			if recorder.Code != test.wantCode {
+				t.Fatalf("got status %d, want %d: %s", recorder.Code, test.wantCode, recorder.Body.String())
+			}
+			if !strings.Contains(recorder.Body.String(), test.wantContain) {
+				t.Errorf("got body %s, want it to contain %q", recorder.Body.String(), test.wantContain)
+			}
+		})
+	}
+}
+
+func TestDeleteService(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name     string
+		path     string
+		wantCode int
+	}{
+		{name: "delete", path: "/namespaces/default/services/web", wantCode: http.StatusOK},
+		{name: "dry run", path: "/namespaces/default/services/web?dryRun=All", wantCode: http.StatusOK},
+		{name: "invalid dry run", path: "/namespaces/default/services/web?dryRun=yes", wantCode: http.StatusBadRequest},
+		{name: "missing", path: "/namespaces/default/services/api", wantCode: http.StatusNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			router := newTestRouter(fake.NewSimpleClientset(newTestService()))
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, test.path, nil))
+			if recorder.Code != test.wantCode {
+				t.Errorf("got status %d, want %d: %s", recorder.Code, test.wantCode, recorder.Body.String())
+			}
+		})
+	}
+}
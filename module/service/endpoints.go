@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/jobayer12/go-kubernetes/module/cluster"
+	"github.com/jobayer12/go-kubernetes/module/pod"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"sort"
+	"strings"
+)
+
+// ServiceEndpointsResponse shows what backs a service: the addresses published
+// in its EndpointSlices and the pods its selector matches.
+type ServiceEndpointsResponse struct {
+	Name      string             `json:"name"`
+	Namespace string             `json:"namespace"`
+	Type      v1.ServiceType     `json:"type"`
+	Selector  map[string]string  `json:"selector,omitempty"`
+	Ports     []v1.ServicePort   `json:"ports"`
+	Ready     []Endpoint         `json:"ready"`
+	NotReady  []Endpoint         `json:"notReady"`
+	Pods      []MatchingPod      `json:"pods"`
+	Findings  []EndpointsFinding `json:"findings"`
+}
+
+type Endpoint struct {
+	Addresses []string       `json:"addresses"`
+	Pod       string         `json:"pod,omitempty"`
+	Node      string         `json:"node,omitempty"`
+	Zone      string         `json:"zone,omitempty"`
+	Serving   bool           `json:"serving"`
+	Ports     []EndpointPort `json:"ports"`
+	// Terminating endpoints belong to pods that are shutting down.
+	Terminating bool `json:"terminating"`
+}
+
+type EndpointPort struct {
+	Name     string      `json:"name,omitempty"`
+	Port     int32       `json:"port"`
+	Protocol v1.Protocol `json:"protocol"`
+}
+
+// MatchingPod is a pod selected by the service selector.
+type MatchingPod struct {
+	Name  string      `json:"name"`
+	IP    string      `json:"ip,omitempty"`
+	Phase v1.PodPhase `json:"phase"`
+	Ready bool        `json:"ready"`
+}
+
+type EndpointsFinding struct {
+	// Severity is critical, warning or info.
+	Severity string `json:"severity"`
+	Reason   string `json:"reason"`
+	Message  string `json:"message"`
+}
+
+// serviceEndpoints collects the EndpointSlices and matching pods of service and
+// flags the usual reasons for a service without working backends.
+func serviceEndpoints(ctx context.Context, client kubernetes.Interface, cache *cluster.Cache, service *v1.Service) (ServiceEndpointsResponse, error) {
+	response := ServiceEndpointsResponse{
+		Name:      service.Name,
+		Namespace: service.Namespace,
+		Type:      service.Spec.Type,
+		Selector:  service.Spec.Selector,
+		Ports:     service.Spec.Ports,
+		Ready:     []Endpoint{},
+		NotReady:  []Endpoint{},
+		Pods:      []MatchingPod{},
+		Findings:  []EndpointsFinding{},
+	}
+	if service.Spec.Type == v1.ServiceTypeExternalName {
+		response.Findings = append(response.Findings, EndpointsFinding{
+			Severity: "info",
+			Reason:   "ExternalName",
+			Message:  fmt.Sprintf("the service is a DNS alias for %s and has no endpoints", service.Spec.ExternalName),
+		})
+		return response, nil
+	}
+
+	slices, err := client.DiscoveryV1().EndpointSlices(service.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: service.Name}).String(),
+	})
+	if err != nil {
+		return response, err
+	}
+	seen := map[string]bool{}
+	for _, slice := range slices.Items {
+		for _, endpoint := range slice.Endpoints {
+			key := strings.Join(endpoint.Addresses, ",")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			item := newEndpoint(endpoint, slice.Ports)
+			// A nil ready condition is an unknown state, which consumers treat as ready.
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				response.Ready = append(response.Ready, item)
+			} else {
+				response.NotReady = append(response.NotReady, item)
+			}
+		}
+	}
+	sortEndpoints(response.Ready)
+	sortEndpoints(response.NotReady)
+
+	if len(service.Spec.Selector) == 0 {
+		response.Findings = append(response.Findings, EndpointsFinding{
+			Severity: "info",
+			Reason:   "NoSelector",
+			Message:  "the service has no selector, its endpoints are managed outside of Kubernetes",
+		})
+	} else {
+		pods, err := cluster.ListPods(ctx, client, cache, service.Namespace, labels.SelectorFromSet(service.Spec.Selector))
+		if err != nil {
+			return response, err
+		}
+		matching, findings := podFindings(service, pods)
+		response.Pods = matching
+		response.Findings = append(response.Findings, findings...)
+	}
+	if len(response.Ready) == 0 {
+		response.Findings = append(response.Findings, EndpointsFinding{
+			Severity: "critical",
+			Reason:   "NoReadyEndpoints",
+			Message:  fmt.Sprintf("no ready endpoints, requests to the service fail (%d not ready)", len(response.NotReady)),
+		})
+	}
+	return response, nil
+}
+
+func newEndpoint(endpoint discoveryv1.Endpoint, ports []discoveryv1.EndpointPort) Endpoint {
+	item := Endpoint{
+		Addresses:   endpoint.Addresses,
+		Serving:     endpoint.Conditions.Serving == nil || *endpoint.Conditions.Serving,
+		Terminating: endpoint.Conditions.Terminating != nil && *endpoint.Conditions.Terminating,
+		Ports:       make([]EndpointPort, 0, len(ports)),
+	}
+	if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+		item.Pod = endpoint.TargetRef.Name
+	}
+	if endpoint.NodeName != nil {
+		item.Node = *endpoint.NodeName
+	}
+	if endpoint.Zone != nil {
+		item.Zone = *endpoint.Zone
+	}
+	for _, port := range ports {
+		endpointPort := EndpointPort{Protocol: v1.ProtocolTCP}
+		if port.Name != nil {
+			endpointPort.Name = *port.Name
+		}
+		if port.Port != nil {
+			endpointPort.Port = *port.Port
+		}
+		if port.Protocol != nil {
+			endpointPort.Protocol = *port.Protocol
+		}
+		item.Ports = append(item.Ports, endpointPort)
+	}
+	return item
+}
+
+func sortEndpoints(endpoints []Endpoint) {
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].Pod != endpoints[j].Pod {
+			return endpoints[i].Pod < endpoints[j].Pod
+		}
+		return strings.Join(endpoints[i].Addresses, ",") < strings.Join(endpoints[j].Addresses, ",")
+	})
+}
+
+// podFindings summarizes the matching pods and reports selectors matching
+// nothing, pods that are not ready and named target ports the pods do not expose.
+func podFindings(service *v1.Service, pods []*v1.Pod) ([]MatchingPod, []EndpointsFinding) {
+	matching := make([]MatchingPod, 0, len(pods))
+	var findings []EndpointsFinding
+	if len(pods) == 0 {
+		findings = append(findings, EndpointsFinding{
+			Severity: "critical",
+			Reason:   "SelectorMatchesNoPods",
+			Message:  fmt.Sprintf("no pod in namespace %s matches the selector %s", service.Namespace, labels.SelectorFromSet(service.Spec.Selector)),
+		})
+	}
+	var notReady []string
+	for _, item := range pods {
+		ready := pod.IsReady(item)
+		matching = append(matching, MatchingPod{Name: item.Name, IP: item.Status.PodIP, Phase: item.Status.Phase, Ready: ready})
+		if !ready {
+			notReady = append(notReady, item.Name)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool { return matching[i].Name < matching[j].Name })
+	if len(notReady) > 0 {
+		sort.Strings(notReady)
+		findings = append(findings, EndpointsFinding{
+			Severity: "warning",
+			Reason:   "PodsNotReady",
+			Message:  fmt.Sprintf("%d of %d matching pods are not ready: %s", len(notReady), len(pods), strings.Join(notReady, ", ")),
+		})
+	}
+	for _, port := range service.Spec.Ports {
+		if port.TargetPort.Type != intstr.String {
+			continue
+		}
+		var missing []string
+		for _, item := range pods {
+			if !hasContainerPort(item, port.TargetPort.StrVal) {
+				missing = append(missing, item.Name)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			findings = append(findings, EndpointsFinding{
+				Severity: "warning",
+				Reason:   "NamedTargetPortMissing",
+				Message:  fmt.Sprintf("target port %q of service port %d is not declared by: %s", port.TargetPort.StrVal, port.Port, strings.Join(missing, ", ")),
+			})
+		}
+	}
+	return matching, findings
+}
+
+func hasContainerPort(pod *v1.Pod, name string) bool {
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func newTestEndpointSlice(name string, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "web"},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints:   endpoints,
+		Ports:       []discoveryv1.EndpointPort{{Name: ptr.To("http"), Port: ptr.To[int32](8080)}},
+	}
+}
+
+func newTestEndpoint(pod, address string, ready *bool) discoveryv1.Endpoint {
+	return discoveryv1.Endpoint{
+		Addresses:  []string{address},
+		Conditions: discoveryv1.EndpointConditions{Ready: ready},
+		TargetRef:  &v1.ObjectReference{Kind: "Pod", Name: pod},
+	}
+}
+
+func findingReasons(findings []EndpointsFinding) []string {
+	reasons := []string{}
+	for _, finding := range findings {
+		reasons = append(reasons, finding.Reason)
+	}
+	return reasons
+}
+
+func TestPodFindings(t *testing.T) {
+	withoutPort := newTestPod("web-c", true)
+	withoutPort.Spec.Containers[0].Ports = nil
+	numbered := newTestService()
+	numbered.Spec.Ports[0].TargetPort = intstr.FromInt(8080)
+	tests := []struct {
+		name         string
+		service      *v1.Service
+		pods         []*v1.Pod
+		wantPods     []MatchingPod
+		wantFindings []string
+	}{
+		{
+			name:         "ready pods",
+			service:      newTestService(),
+			pods:         []*v1.Pod{newTestPod("web-b", true), newTestPod("web-a", true)},
+			wantPods:     []MatchingPod{{Name: "web-a", Phase: v1.PodRunning, Ready: true}, {Name: "web-b", Phase: v1.PodRunning, Ready: true}},
+			wantFindings: []string{},
+		},
+		{
+			name:         "no pods",
+			service:      newTestService(),
+			wantPods:     []MatchingPod{},
+			wantFindings: []string{"SelectorMatchesNoPods"},
+		},
+		{
+			name:         "not ready pods",
+			service:      newTestService(),
+			pods:         []*v1.Pod{newTestPod("web-a", true), newTestPod("web-b", false)},
+			wantPods:     []MatchingPod{{Name: "web-a", Phase: v1.PodRunning, Ready: true}, {Name: "web-b", Phase: v1.PodRunning}},
+			wantFindings: []string{"PodsNotReady"},
+		},
+		{
+			name:         "named target port missing",
+			service:      newTestService(),
+			pods:         []*v1.Pod{newTestPod("web-a", true), withoutPort},
+			wantPods:     []MatchingPod{{Name: "web-a", Phase: v1.PodRunning, Ready: true}, {Name: "web-c", Phase: v1.PodRunning, Ready: true}},
+			wantFindings: []string{"NamedTargetPortMissing"},
+		},
+		{
+			name:         "numbered target port",
+			service:      numbered,
+			pods:         []*v1.Pod{withoutPort},
+			wantPods:     []MatchingPod{{Name: "web-c", Phase: v1.PodRunning, Ready: true}},
+			wantFindings: []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pods, findings := podFindings(test.service, test.pods)
+			if !reflect.DeepEqual(pods, test.wantPods) {
+				t.Errorf("got pods %+v, want %+v", pods, test.wantPods)
+			}
+			if reasons := findingReasons(findings); !reflect.DeepEqual(reasons, test.wantFindings) {
+				t.Errorf("got findings %v, want %v", reasons, test.wantFindings)
+			}
+		})
+	}
+}
+
+func TestServiceEndpoints(t *testing.T) {
+	otherService := newTestEndpointSlice("api-1", newTestEndpoint("api-a", "10.0.1.1", nil))
+	otherService.Labels[discoveryv1.LabelServiceName] = "api"
+	tests := []struct {
+		name         string
+		service      *v1.Service
+		objects      []*discoveryv1.EndpointSlice
+		pods         []*v1.Pod
+		wantReady    []string
+		wantNotReady []string
+		wantFindings []string
+	}{
+		{
+			name:    "ready endpoints",
+			service: newTestService(),
+			objects: []*discoveryv1.EndpointSlice{
+				newTestEndpointSlice("web-1", newTestEndpoint("web-b", "10.0.0.2", ptr.To(true)), newTestEndpoint("web-a", "10.0.0.1", nil)),
+				// The same address published by a second slice during a resync.
+				newTestEndpointSlice("web-2", newTestEndpoint("web-a", "10.0.0.1", ptr.To(true))),
+				otherService,
+			},
+			pods:         []*v1.Pod{newTestPod("web-a", true), newTestPod("web-b", true)},
+			wantReady:    []string{"web-a", "web-b"},
+			wantNotReady: []string{},
+			wantFindings: []string{},
+		},
+		{
+			name:         "not ready endpoints",
+			service:      newTestService(),
+			objects:      []*discoveryv1.EndpointSlice{newTestEndpointSlice("web-1", newTestEndpoint("web-a", "10.0.0.1", ptr.To(false)))},
+			pods:         []*v1.Pod{newTestPod("web-a", false)},
+			wantReady:    []string{},
+			wantNotReady: []string{"web-a"},
+			wantFindings: []string{"PodsNotReady", "NoReadyEndpoints"},
+		},
+		{
+			name:         "missing endpoints",
+			service:      newTestService(),
+			wantReady:    []string{},
+			wantNotReady: []string{},
+			wantFindings: []string{"SelectorMatchesNoPods", "NoReadyEndpoints"},
+		},
+		{
+			name: "pods of other services are ignored",
+			service: func() *v1.Service {
+				service := newTestService()
+				service.Spec.Selector = map[string]string{"app": "api"}
+				return service
+			}(),
+			pods:         []*v1.Pod{newTestPod("web-a", true)},
+			wantReady:    []string{},
+			wantNotReady: []string{},
+			wantFindings: []string{"SelectorMatchesNoPods", "NoReadyEndpoints"},
+		},
+		{
+			name: "no selector",
+			service: func() *v1.Service {
+				service := newTestService()
+				service.Spec.Selector = nil
+				return service
+			}(),
+			objects:      []*discoveryv1.EndpointSlice{newTestEndpointSlice("web-1", discoveryv1.Endpoint{Addresses: []string{"192.168.1.10"}})},
+			wantReady:    []string{""},
+			wantNotReady: []string{},
+			wantFindings: []string{"NoSelector"},
+		},
+		{
+			name: "external name",
+			service: func() *v1.Service {
+				service := newTestService()
+				service.Spec.Type = v1.ServiceTypeExternalName
+				service.Spec.ExternalName = "db.example.com"
+				return service
+			}(),
+			wantReady:    []string{},
+			wantNotReady: []string{},
+			wantFindings: []string{"ExternalName"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			for _, slice := range test.objects {
+				if err := client.Tracker().Add(slice); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, pod := range test.pods {
+				if err := client.Tracker().Add(pod); err != nil {
+					t.Fatal(err)
+				}
+			}
+			response, err := serviceEndpoints(context.Background(), client, nil, test.service)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, check := range []struct {
+				kind      string
+				endpoints []Endpoint
+				want      []string
+			}{
+				{"ready", response.Ready, test.wantReady},
+				{"not ready", response.NotReady, test.wantNotReady},
+			} {
+				pods := []string{}
+				for _, endpoint := range check.endpoints {
+					pods = append(pods, endpoint.Pod)
+				}
+				if !reflect.DeepEqual(pods, check.want) {
+					t.Errorf("got %s endpoints of %v, want %v", check.kind, pods, check.want)
+				}
+			}
+			if reasons := findingReasons(response.Findings); !reflect.DeepEqual(reasons, test.wantFindings) {
+				t.Errorf("got findings %v, want %v", reasons, test.wantFindings)
+			}
+		})
+	}
+}
+
+func TestGetServiceEndpoints(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := newTestRouter(fake.NewSimpleClientset(
+		newTestService(),
+		newTestEndpointSlice("web-1", newTestEndpoint("web-a", "10.0.0.1", ptr.To(true))),
+		newTestPod("web-a", true),
+	))
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/namespaces/default/services/web/endpoints", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("got status %d: %s", recorder.Code, recorder.Body.String())
+	}
+	var response ServiceEndpointsResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	want := []EndpointPort{{Name: "http", Port: 8080, Protocol: v1.ProtocolTCP}}
+	if len(response.Ready) != 1 || !reflect.DeepEqual(response.Ready[0].Ports, want) {
+		t.Errorf("got ready endpoints %+v, want one with ports %+v", response.Ready, want)
+	}
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/namespaces/default/services/api/endpoints", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("got status %d, want %d", recorder.Code, http.StatusNotFound)
+	}
+}
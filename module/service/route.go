@@ -0,0 +1,25 @@
+package service
+
+import "github.com/gin-gonic/gin"
+
+type Route struct {
+	controller Controller
+}
+
+func NewServiceRoute(controller Controller) Route {
+	return Route{controller}
+}
+
+// AllNamespacesRoute mounts the cross-namespace service list, e.g. on /api/v1/services.
+func (r *Route) AllNamespacesRoute(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListAllService)
+}
+
+func (r *Route) Route(router *gin.RouterGroup) {
+	router.GET("", r.controller.ListService)
+	router.POST("", r.controller.CreateService)
+	router.GET(":name", r.controller.GetService)
+	router.PATCH(":name", r.controller.PatchService)
+	router.DELETE(":name", r.controller.DeleteService)
+	router.GET(":name/endpoints", r.controller.GetServiceEndpoints)
+}